@@ -0,0 +1,294 @@
+// Package api serves the holiday queries of package holidays as JSON over
+// HTTP and provides a client for them. The API is described by the OpenAPI
+// document returned by OpenAPI.
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+//go:embed openapi.yaml
+var openAPI []byte
+
+// OpenAPI returns the OpenAPI 3 description of the API in YAML.
+func OpenAPI() []byte {
+	return append([]byte(nil), openAPI...)
+}
+
+const dateFormat = "2006-01-02"
+
+// maxYears limits the range of /v1/business-days.
+const maxYears = 100
+
+// minYear and maxYear bound the years of all parameters, as dates are
+// written with four-digit years.
+const (
+	minYear = 1
+	maxYear = 9999
+)
+
+// HolidaysResponse is the response of /v1/holidays.
+type HolidaysResponse struct {
+	Holidays []holidays.Holiday `json:"holidays"`
+}
+
+// IsHolidayResponse is the response of /v1/is-holiday.
+type IsHolidayResponse struct {
+	Date        string             `json:"date"`
+	IsHoliday   bool               `json:"is_holiday"`
+	BusinessDay bool               `json:"business_day"`
+	Holidays    []holidays.Holiday `json:"holidays"`
+}
+
+// NextResponse is the response of /v1/next.
+type NextResponse struct {
+	Holiday holidays.Holiday `json:"holiday"`
+}
+
+// BusinessDaysResponse is the response of /v1/business-days.
+type BusinessDaysResponse struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	BusinessDays int    `json:"business_days"`
+}
+
+// ErrorResponse is returned with all status codes other than 200.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server answers the requests of the API with the holidays of a set.
+type Server struct {
+	holidays *holidays.Set
+	calendar *holidays.BusinessCalendar
+	mux      *http.ServeMux
+	// now returns the current time, which is the default date of /v1/next
+	// and the default year of /v1/holidays.
+	now func() time.Time
+}
+
+// NewServer returns a server for the holidays of the set.
+func NewServer(s *holidays.Set) *Server {
+	srv := &Server{
+		holidays: s,
+		calendar: s.BusinessCalendar(),
+		mux:      http.NewServeMux(),
+		now:      time.Now,
+	}
+
+	srv.mux.HandleFunc("/v1/holidays", srv.handleHolidays)
+	srv.mux.HandleFunc("/v1/is-holiday", srv.handleIsHoliday)
+	srv.mux.HandleFunc("/v1/next", srv.handleNext)
+	srv.mux.HandleFunc("/v1/business-days", srv.handleBusinessDays)
+	srv.mux.HandleFunc("/v1/openapi.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(openAPI)
+	})
+	srv.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+	})
+
+	return srv
+}
+
+func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+		return
+	}
+	srv.mux.ServeHTTP(w, r)
+}
+
+func (srv *Server) handleHolidays(w http.ResponseWriter, r *http.Request) {
+	q := query{values: r.URL.Query()}
+	year := q.year("year", srv.now().Year())
+	filters := q.filters(q.region(), holidays.AllKinds)
+	if q.invalid(w) {
+		return
+	}
+
+	writeJSON(w, HolidaysResponse{
+		Holidays: srv.holidays.HolidaysBetween(
+			time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+			filters...,
+		),
+	})
+}
+
+func (srv *Server) handleIsHoliday(w http.ResponseWriter, r *http.Request) {
+	q := query{values: r.URL.Query()}
+	date := q.date("date", time.Time{})
+	region := q.region()
+	filters := q.filters(region, []holidays.Kind{holidays.PublicHoliday, holidays.RegionalPublicHoliday})
+	if q.invalid(w) {
+		return
+	}
+
+	hs := srv.holidays.HolidaysOn(date, filters...)
+	writeJSON(w, IsHolidayResponse{
+		Date:        date.Format(dateFormat),
+		IsHoliday:   len(hs) > 0,
+		BusinessDay: srv.calendar.IsBusinessDay(date, region),
+		Holidays:    hs,
+	})
+}
+
+func (srv *Server) handleNext(w http.ResponseWriter, r *http.Request) {
+	q := query{values: r.URL.Query()}
+	date := q.date("date", srv.now())
+	filters := q.filters(q.region(), holidays.AllKinds)
+	if q.invalid(w) {
+		return
+	}
+
+	h, ok := srv.holidays.NextHoliday(date, filters...)
+	if !ok {
+		writeError(w, http.StatusNotFound, "no holiday found")
+		return
+	}
+	writeJSON(w, NextResponse{Holiday: h})
+}
+
+func (srv *Server) handleBusinessDays(w http.ResponseWriter, r *http.Request) {
+	q := query{values: r.URL.Query()}
+	from := q.date("from", time.Time{})
+	to := q.date("to", time.Time{})
+	region := q.region()
+	if to.Before(from) {
+		q.errors = append(q.errors, "to is before from")
+	} else if to.After(from.AddDate(maxYears, 0, 0)) {
+		q.errors = append(q.errors, fmt.Sprintf("more than %d years requested", maxYears))
+	}
+	if q.invalid(w) {
+		return
+	}
+
+	// The end of the range is inclusive
+	writeJSON(w, BusinessDaysResponse{
+		From:         from.Format(dateFormat),
+		To:           to.Format(dateFormat),
+		BusinessDays: srv.calendar.BusinessDaysBetween(from, to.AddDate(0, 0, 1), region),
+	})
+}
+
+// query parses the parameters of a request and collects the errors.
+type query struct {
+	values url.Values
+	errors []string
+}
+
+func (q *query) get(name string) (string, bool) {
+	if !q.values.Has(name) {
+		return "", false
+	}
+	return q.values.Get(name), true
+}
+
+func (q *query) int(name string, def int) int {
+	s, ok := q.get(name)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		q.errors = append(q.errors, fmt.Sprintf("invalid %s '%s'", name, s))
+	}
+	return n
+}
+
+// year returns the year of the parameter if it lies between minYear and
+// maxYear.
+func (q *query) year(name string, def int) int {
+	n := len(q.errors)
+	year := q.int(name, def)
+	if len(q.errors) == n && (year < minYear || year > maxYear) {
+		q.errors = append(q.errors, fmt.Sprintf("%s %d is not between %d and %d", name, year, minYear, maxYear))
+	}
+	return year
+}
+
+// date returns the date of the parameter. The parameter is required if the
+// default is the zero time. The year of the date must lie between minYear
+// and maxYear.
+func (q *query) date(name string, def time.Time) time.Time {
+	s, ok := q.get(name)
+	if !ok {
+		if def.IsZero() {
+			q.errors = append(q.errors, fmt.Sprintf("missing %s", name))
+		}
+		return time.Date(def.Year(), def.Month(), def.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	date, err := time.Parse(dateFormat, s)
+	if err != nil {
+		q.errors = append(q.errors, fmt.Sprintf("invalid %s '%s'", name, s))
+	} else if date.Year() < minYear || date.Year() > maxYear {
+		q.errors = append(q.errors, fmt.Sprintf("%s %s is not between the years %d and %d", name, s, minYear, maxYear))
+	}
+	return date
+}
+
+func (q *query) region() holidays.Region {
+	s, ok := q.get("region")
+	if !ok || s == "" {
+		return ""
+	}
+	region, err := holidays.ParseRegion(s)
+	if err != nil {
+		q.errors = append(q.errors, err.Error())
+	}
+	return region
+}
+
+// filters returns the filters for the region and the kinds parameter.
+func (q *query) filters(region holidays.Region, defaultKinds []holidays.Kind) []holidays.Filter {
+	var filters []holidays.Filter
+	if region != "" {
+		filters = append(filters, holidays.InRegion(region))
+	}
+
+	kinds := defaultKinds
+	if s, ok := q.get("kinds"); ok {
+		kinds = nil
+		for _, name := range strings.Split(s, ",") {
+			kind, err := holidays.ParseKind(strings.TrimSpace(name))
+			if err != nil {
+				q.errors = append(q.errors, err.Error())
+				continue
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return append(filters, holidays.OfKind(kinds...))
+}
+
+// invalid writes the errors of the query if there are any.
+func (q *query) invalid(w http.ResponseWriter) bool {
+	if len(q.errors) == 0 {
+		return false
+	}
+	writeError(w, http.StatusBadRequest, strings.Join(q.errors, "; "))
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+}
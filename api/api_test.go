@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func newTestClient(t *testing.T) *Client {
+	srv := NewServer(holidays.Builtin())
+	srv.now = func() time.Time { return date(2025, 5, 1) }
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return NewClient(ts.URL)
+}
+
+func TestHolidays(t *testing.T) {
+	c := newTestClient(t)
+
+	hs, err := c.Holidays(context.Background(), 2025, holidays.Bayern, holidays.PublicHoliday, holidays.RegionalPublicHoliday)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hs) != 13 {
+		t.Errorf("got %d holidays; want 13", len(hs))
+	}
+	if hs[0].ID != "new-year" || !hs[0].Date.Equal(date(2025, 1, 1)) {
+		t.Errorf("got %s on %s; want new-year on 2025-01-01", hs[0].ID, hs[0].Date.Format(dateFormat))
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	c := newTestClient(t)
+
+	testCases := []struct {
+		date        time.Time
+		region      holidays.Region
+		isHoliday   bool
+		businessDay bool
+	}{
+		{date(2025, 8, 15), holidays.Saarland, true, false},
+		{date(2025, 8, 15), holidays.Hamburg, false, true},
+		{date(2025, 8, 15), holidays.Sachsen, false, true},
+		{date(2025, 8, 8), holidays.Bayern, false, true},
+		{date(2025, 8, 16), holidays.Saarland, false, false},
+		{date(2025, 12, 25), "", true, false},
+	}
+
+	for _, tc := range testCases {
+		resp, err := c.IsHoliday(context.Background(), tc.date, tc.region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.IsHoliday != tc.isHoliday || resp.BusinessDay != tc.businessDay {
+			t.Errorf("%s in %s: got holiday %t, business day %t; want %t, %t",
+				tc.date.Format(dateFormat), tc.region, resp.IsHoliday, resp.BusinessDay, tc.isHoliday, tc.businessDay)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	c := newTestClient(t)
+
+	h, ok, err := c.Next(context.Background(), date(2025, 10, 3), holidays.Hamburg, holidays.PublicHoliday, holidays.RegionalPublicHoliday)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || h.ID != "reformation-day" {
+		t.Errorf("got %s, %t; want reformation-day", h.ID, ok)
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	c := newTestClient(t)
+
+	// May 2025 has 22 weekdays, of which Labour Day and Ascension Day are
+	// holidays
+	n, err := c.BusinessDays(context.Background(), date(2025, 5, 1), date(2025, 5, 31), holidays.Bayern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 20 {
+		t.Errorf("got %d business days; want 20", n)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newTestClient(t)
+
+	_, err := c.Holidays(context.Background(), 2025, "DE-XX")
+	if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %v; want bad request", err)
+	}
+
+	_, err = c.BusinessDays(context.Background(), date(2025, 5, 31), date(2025, 5, 1), "")
+	if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusBadRequest {
+		t.Errorf("got %v; want bad request", err)
+	}
+}
+
+func TestYearBounds(t *testing.T) {
+	c := newTestClient(t)
+
+	testCases := []string{
+		"/v1/holidays?year=0",
+		"/v1/holidays?year=-1",
+		"/v1/holidays?year=10000",
+		"/v1/holidays?year=9223372036854775807",
+		"/v1/is-holiday?date=0000-01-01",
+		"/v1/next?date=0000-12-31",
+		"/v1/business-days?from=0000-01-01&to=0001-01-31",
+	}
+
+	for _, path := range testCases {
+		t.Run(path, func(t *testing.T) {
+			resp, err := http.Get(c.BaseURL + path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("got status %d; want %d", resp.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+
+	if _, err := c.Holidays(context.Background(), 9999, ""); err != nil {
+		t.Errorf("year 9999: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+// Client queries a server of the API.
+type Client struct {
+	// BaseURL is the URL the paths of the API are relative to, e.g.
+	// "http://localhost:8080".
+	BaseURL string
+	// HTTPClient is used for the requests. If nil, http.DefaultClient is
+	// used.
+	HTTPClient *http.Client
+}
+
+// NewClient returns a client for the server at the base URL.
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: baseURL}
+}
+
+// Error is returned for responses with a status code other than 200.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Holidays returns the holidays of the year in the region. If no kinds are
+// given, holidays of all kinds are returned.
+func (c *Client) Holidays(ctx context.Context, year int, region holidays.Region, kinds ...holidays.Kind) ([]holidays.Holiday, error) {
+	params := url.Values{"year": {strconv.Itoa(year)}}
+	setRegion(params, region)
+	setKinds(params, kinds)
+
+	var resp HolidaysResponse
+	if err := c.get(ctx, "/v1/holidays", params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Holidays, nil
+}
+
+// IsHoliday returns the public holidays on the date in the region.
+func (c *Client) IsHoliday(ctx context.Context, date time.Time, region holidays.Region) (IsHolidayResponse, error) {
+	params := url.Values{"date": {date.Format(dateFormat)}}
+	setRegion(params, region)
+
+	var resp IsHolidayResponse
+	err := c.get(ctx, "/v1/is-holiday", params, &resp)
+	return resp, err
+}
+
+// Next returns the first holiday after the date in the region. If no kinds
+// are given, holidays of all kinds are considered. The result is false if
+// no holiday was found.
+func (c *Client) Next(ctx context.Context, date time.Time, region holidays.Region, kinds ...holidays.Kind) (holidays.Holiday, bool, error) {
+	params := url.Values{"date": {date.Format(dateFormat)}}
+	setRegion(params, region)
+	setKinds(params, kinds)
+
+	var resp NextResponse
+	err := c.get(ctx, "/v1/next", params, &resp)
+	if e, ok := err.(*Error); ok && e.StatusCode == http.StatusNotFound {
+		return holidays.Holiday{}, false, nil
+	}
+	if err != nil {
+		return holidays.Holiday{}, false, err
+	}
+	return resp.Holiday, true, nil
+}
+
+// BusinessDays returns the number of business days from the date from up to
+// and including the date to in the region.
+func (c *Client) BusinessDays(ctx context.Context, from, to time.Time, region holidays.Region) (int, error) {
+	params := url.Values{"from": {from.Format(dateFormat)}, "to": {to.Format(dateFormat)}}
+	setRegion(params, region)
+
+	var resp BusinessDaysResponse
+	if err := c.get(ctx, "/v1/business-days", params, &resp); err != nil {
+		return 0, err
+	}
+	return resp.BusinessDays, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	u := strings.TrimSuffix(c.BaseURL, "/") + path + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&e); err != nil {
+			e.Error = resp.Status
+		}
+		return &Error{StatusCode: resp.StatusCode, Message: e.Error}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func setRegion(params url.Values, region holidays.Region) {
+	if region != "" {
+		params.Set("region", string(region))
+	}
+}
+
+func setKinds(params url.Values, kinds []holidays.Kind) {
+	if len(kinds) == 0 {
+		return
+	}
+	names := make([]string, len(kinds))
+	for i, kind := range kinds {
+		names[i] = kind.String()
+	}
+	params.Set("kinds", strings.Join(names, ","))
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+// alarm is a reminder of an event.
+type alarm struct {
+	// trigger is the RFC 5545 duration relative to the start of the event,
+	// e.g. "-P2D".
+	trigger     string
+	description string
+}
+
+// alarmRule adds an alarm to the events of holidays of some kinds.
+type alarmRule struct {
+	kinds   []holidays.Kind
+	trigger string
+	// description may contain the placeholders {name} and {date}. If empty,
+	// the name of the holiday is used.
+	description string
+}
+
+// alarmFlag collects the alarm rules given with -alarm.
+type alarmFlag []alarmRule
+
+func (f *alarmFlag) register(flags *flag.FlagSet) {
+	flags.Var(f, "alarm", "add a reminder as [KINDS:]TRIGGER[:DESCRIPTION], e.g. public:-2d:'{name} on {date}' (repeatable; KINDS default to public,regional)")
+}
+
+func (f *alarmFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	rules := make([]string, len(*f))
+	for i, rule := range *f {
+		kinds := make([]string, len(rule.kinds))
+		for j, kind := range rule.kinds {
+			kinds[j] = kind.String()
+		}
+		rules[i] = strings.Join(kinds, ",") + ":" + rule.trigger
+		if rule.description != "" {
+			rules[i] += ":" + rule.description
+		}
+	}
+	return strings.Join(rules, " ")
+}
+
+// Set parses a rule of the form [KINDS:]TRIGGER[:DESCRIPTION]. The kinds
+// default to public and regional holidays.
+func (f *alarmFlag) Set(s string) error {
+	rule := alarmRule{kinds: []holidays.Kind{holidays.PublicHoliday, holidays.RegionalPublicHoliday}}
+
+	parts := strings.SplitN(s, ":", 3)
+	if _, err := parseTrigger(parts[0]); err != nil && len(parts) > 1 {
+		rule.kinds = nil
+		for _, name := range strings.Split(parts[0], ",") {
+			kind, err := holidays.ParseKind(strings.TrimSpace(name))
+			if err != nil {
+				return err
+			}
+			rule.kinds = append(rule.kinds, kind)
+		}
+		parts = parts[1:]
+	} else if len(parts) == 3 {
+		// The description may contain colons
+		parts = []string{parts[0], parts[1] + ":" + parts[2]}
+	}
+
+	trigger, err := parseTrigger(parts[0])
+	if err != nil {
+		return err
+	}
+	rule.trigger = trigger
+	if len(parts) > 1 {
+		rule.description = parts[1]
+	}
+
+	*f = append(*f, rule)
+	return nil
+}
+
+var (
+	triggerPart     = regexp.MustCompile(`^(\d+)([wdhm])`)
+	triggerDuration = regexp.MustCompile(`^[+-]?P(\d+W|\d+D(T(\d+H)?(\d+M)?(\d+S)?)?|T(\d+H)?(\d+M)?(\d+S)?)$`)
+)
+
+// parseTrigger converts a trigger like -2d or -1d12h with the units w, d, h
+// and m for minutes into an RFC 5545 duration. Durations like -P2D are
+// accepted as well.
+func parseTrigger(s string) (string, error) {
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		if !triggerDuration.MatchString(s) || strings.HasSuffix(s, "T") {
+			return "", fmt.Errorf("invalid trigger '%s'", s)
+		}
+		return s, nil
+	}
+
+	sign, rest := "", s
+	if strings.HasPrefix(rest, "-") || strings.HasPrefix(rest, "+") {
+		sign, rest = rest[:1], rest[1:]
+	}
+	if sign == "+" {
+		sign = ""
+	}
+	if rest == "" {
+		return "", fmt.Errorf("invalid trigger '%s'", s)
+	}
+
+	values := map[byte]int{}
+	for rest != "" {
+		m := triggerPart.FindStringSubmatch(rest)
+		if m == nil {
+			return "", fmt.Errorf("invalid trigger '%s'", s)
+		}
+		n, _ := strconv.Atoi(m[1])
+		values[m[2][0]] += n
+		rest = rest[len(m[0]):]
+	}
+
+	duration := sign + "P"
+	if values['w'] > 0 && values['d'] == 0 && values['h'] == 0 && values['m'] == 0 {
+		return duration + fmt.Sprintf("%dW", values['w']), nil
+	}
+	if days := 7*values['w'] + values['d']; days > 0 {
+		duration += fmt.Sprintf("%dD", days)
+	}
+	if values['h'] > 0 || values['m'] > 0 {
+		duration += "T"
+		if values['h'] > 0 {
+			duration += fmt.Sprintf("%dH", values['h'])
+		}
+		if values['m'] > 0 {
+			duration += fmt.Sprintf("%dM", values['m'])
+		}
+	}
+	if duration == sign+"P" {
+		duration += "0D"
+	}
+	return duration, nil
+}
+
+// alarms returns the alarms of the rules that apply to the holiday.
+func (f alarmFlag) alarms(h *holidays.Holiday, name, date string) []alarm {
+	var alarms []alarm
+	for _, rule := range f {
+		for _, kind := range rule.kinds {
+			if h.Kind != kind {
+				continue
+			}
+			description := name
+			if rule.description != "" {
+				description = strings.NewReplacer("{name}", name, "{date}", date).Replace(rule.description)
+			}
+			alarms = append(alarms, alarm{trigger: rule.trigger, description: description})
+			break
+		}
+	}
+	return alarms
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+func TestParseTrigger(t *testing.T) {
+	testCases := []struct {
+		trigger string
+		want    string
+		wantErr bool
+	}{
+		{"-2d", "-P2D", false},
+		{"-1d12h", "-P1DT12H", false},
+		{"+2d", "P2D", false},
+		{"2d", "P2D", false},
+		{"-1w", "-P1W", false},
+		{"-1w1d", "-P8D", false},
+		{"-30m", "-PT30M", false},
+		{"-1h30m", "-PT1H30M", false},
+		{"-0d", "-P0D", false},
+		{"-P2D", "-P2D", false},
+		{"-P1DT12H", "-P1DT12H", false},
+		{"PT15M", "PT15M", false},
+		{"-P1W", "-P1W", false},
+		{"-P1DT", "", true},
+		{"-P1H", "", true},
+		{"-PT", "", true},
+		{"-", "", true},
+		{"", "", true},
+		{"-2", "", true},
+		{"-2x", "", true},
+		{"-d", "", true},
+		{"--2d", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.trigger, func(t *testing.T) {
+			got, err := parseTrigger(tc.trigger)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("got %s; want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlarmFlagSet(t *testing.T) {
+	defaultKinds := []holidays.Kind{holidays.PublicHoliday, holidays.RegionalPublicHoliday}
+
+	testCases := []struct {
+		value   string
+		want    alarmRule
+		wantErr bool
+	}{
+		{"-2d", alarmRule{kinds: defaultKinds, trigger: "-P2D"}, false},
+		{"-2d:{name} on {date}", alarmRule{kinds: defaultKinds, trigger: "-P2D", description: "{name} on {date}"}, false},
+		{"-2d:Tomorrow: {name}", alarmRule{kinds: defaultKinds, trigger: "-P2D", description: "Tomorrow: {name}"}, false},
+		{"observance:-1w", alarmRule{kinds: []holidays.Kind{holidays.Observance}, trigger: "-P1W"}, false},
+		{"public, commemoration:-P1D:At 9:00", alarmRule{kinds: []holidays.Kind{holidays.PublicHoliday, holidays.Commemoration}, trigger: "-P1D", description: "At 9:00"}, false},
+		{"holiday:-1d", alarmRule{}, true},
+		{"public:tomorrow", alarmRule{}, true},
+		{"tomorrow", alarmRule{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			var f alarmFlag
+			err := f.Set(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(f) != 1 || !reflect.DeepEqual(f[0], tc.want) {
+				t.Errorf("got %+v; want %+v", f, tc.want)
+			}
+		})
+	}
+}
+
+func TestAlarms(t *testing.T) {
+	var f alarmFlag
+	for _, value := range []string{"-2d:{name} on {date}", "observance,public:-1w"} {
+		if err := f.Set(value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	public := &holidays.Holiday{Kind: holidays.PublicHoliday}
+	want := []alarm{{"-P2D", "Neujahr on 1.1."}, {"-P1W", "Neujahr"}}
+	if got := f.alarms(public, "Neujahr", "1.1."); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v; want %+v", got, want)
+	}
+
+	clockChange := &holidays.Holiday{Kind: holidays.ClockChange}
+	if got := f.alarms(clockChange, "Zeitumstellung", "30.3."); len(got) != 0 {
+		t.Errorf("got %+v; want no alarms", got)
+	}
+}
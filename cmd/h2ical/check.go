@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+// check lints the built-in holiday definitions and those of an optional
+// definitions file.
+func check(flags *flag.FlagSet, args []string) error {
+	fromYear := flags.Int("from", time.Now().Year(), "first year to evaluate the rules for")
+	tillYear := flags.Int("till", time.Now().Year()+10, "last year to evaluate the rules for")
+	langList := flags.String("lang", "", "comma-separated list of the languages to check (default all translated languages)")
+	defsPath := flags.String("defs", "", "YAML or JSON file with additional holiday definitions")
+
+	flags.Parse(args)
+
+	var errs errorList
+	if flags.NArg() > 0 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " ")))
+	}
+	if *fromYear > *tillYear {
+		errs.add(fmt.Errorf("-from %d is after -till %d", *fromYear, *tillYear))
+	}
+	langs := holidays.Languages()
+	if *langList != "" {
+		langs = nil
+		for _, name := range strings.Split(*langList, ",") {
+			lang, err := language.Parse(strings.TrimSpace(name))
+			if err != nil {
+				errs.add(fmt.Errorf("invalid language tag '%s'", name))
+				continue
+			}
+			langs = append(langs, lang)
+		}
+	}
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+
+	definitions := holidays.Builtin().Definitions()
+	if *defsPath != "" {
+		f, err := os.Open(*defsPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		// Lint reports the invalid definitions along with all other problems
+		custom, err := holidays.DecodeDefinitions(f)
+		if err != nil {
+			return invalidError{fmt.Errorf("%s: %w", *defsPath, err)}
+		}
+		definitions = append(definitions, custom...)
+	}
+
+	var errors, warnings int
+	for _, problem := range holidays.Lint(definitions, langs, *fromYear, *tillYear) {
+		fmt.Println(problem)
+		if problem.Warning {
+			warnings++
+		} else {
+			errors++
+		}
+	}
+	fmt.Printf("%d errors, %d warnings\n", errors, warnings)
+
+	if errors > 0 {
+		return invalidError{fmt.Errorf("found %d errors", errors)}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+// csvColumns lists the columns available in the csv and tsv formats.
+var csvColumns = []string{"date", "weekday", "id", "name", "description", "kind", "regions", "workday"}
+
+const defaultCSVColumns = "date,weekday,name,regions,kind,workday"
+
+func parseColumns(s string) ([]string, error) {
+	var columns []string
+	var errs errorList
+
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if !contains(csvColumns, name) {
+			errs.add(fmt.Errorf("unknown column '%s'", name))
+			continue
+		}
+		columns = append(columns, name)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return columns, nil
+}
+
+// writeCSV writes a header and a row for each of the holidays. Nationwide
+// holidays have the region DE. Holidays on a weekend or public holidays of
+// the selected region (nationwide ones if no region is selected) are no
+// working days.
+func writeCSV(w io.Writer, hs []holidays.Holiday, s selection, columns []string, comma rune) error {
+	calendar := holidays.Builtin().BusinessCalendar()
+	weekdays := lookupLocale(s.lang).weekdays
+	out := csv.NewWriter(w)
+	out.Comma = comma
+
+	if err := out.Write(columns); err != nil {
+		return err
+	}
+
+	for _, h := range hs {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			switch column {
+			case "date":
+				record[i] = h.Date.Format("2006-01-02")
+			case "weekday":
+				record[i] = weekdays[h.Date.Weekday()]
+			case "id":
+				record[i] = h.ID
+			case "name":
+				record[i] = h.Name.Translate(s.lang)
+			case "description":
+				record[i] = h.Description.Translate(s.lang)
+			case "kind":
+				record[i] = h.Kind.String()
+			case "regions":
+				if h.Nationwide {
+					record[i] = "DE"
+					break
+				}
+				regions := make([]string, len(h.Regions))
+				for j, r := range h.Regions {
+					regions[j] = string(r)
+				}
+				record[i] = strings.Join(regions, ",")
+			case "workday":
+				record[i] = strconv.FormatBool(calendar.IsBusinessDay(h.Date, s.region))
+			}
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
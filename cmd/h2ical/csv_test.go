@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+func TestParseColumns(t *testing.T) {
+	columns, err := parseColumns(defaultCSVColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(columns, ","); got != defaultCSVColumns {
+		t.Errorf("got %s; want %s", got, defaultCSVColumns)
+	}
+
+	columns, err = parseColumns(" id , kind")
+	if err != nil || strings.Join(columns, ",") != "id,kind" {
+		t.Errorf("got %v, %v; want [id kind]", columns, err)
+	}
+
+	if _, err := parseColumns("date,holiday,"); err == nil {
+		t.Error("expected error for unknown columns")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var hs []holidays.Holiday
+	for _, id := range []string{"new-year", "epiphany", "valentines-day"} {
+		h, err := holidays.Builtin().Holiday(id, 2026)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hs = append(hs, h)
+	}
+
+	testCases := []struct {
+		name    string
+		lang    language.Tag
+		region  holidays.Region
+		columns string
+		comma   rune
+		want    []string
+	}{
+		{"default", language.English, "", defaultCSVColumns, ',', []string{
+			"date,weekday,name,regions,kind,workday",
+			"2026-01-01,Thursday,New Year,DE,public,false",
+			`2026-01-06,Tuesday,Epiphany,"DE-BW,DE-BY,DE-ST",regional,true`,
+			"2026-02-14,Saturday,Valentine's Day,DE,observance,false",
+		}},
+		{"region", language.English, holidays.Bayern, "date,workday", ',', []string{
+			"date,workday",
+			"2026-01-01,false",
+			"2026-01-06,false",
+			"2026-02-14,false",
+		}},
+		{"other region", language.English, holidays.Hamburg, "id,workday", ',', []string{
+			"id,workday",
+			"new-year,false",
+			"epiphany,true",
+			"valentines-day,false",
+		}},
+		{"German", language.German, "", "weekday,name,description", ',', []string{
+			"weekday,name,description",
+			"Donnerstag,Neujahrstag,Gesetzlicher Feiertag",
+			`Dienstag,Heilige Drei Könige,"Feiertag in Baden-Württemberg, Bayern, Sachsen-Anhalt"`,
+			"Samstag,Valentinstag,Gedenktag",
+		}},
+		{"TSV", language.English, "", "date,regions,kind", '\t', []string{
+			"date\tregions\tkind",
+			"2026-01-01\tDE\tpublic",
+			"2026-01-06\tDE-BW,DE-BY,DE-ST\tregional",
+			"2026-02-14\tDE\tobservance",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			columns, err := parseColumns(tc.columns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s := selection{lang: tc.lang, region: tc.region}
+
+			var b bytes.Buffer
+			if err := writeCSV(&b, hs, s, columns, tc.comma); err != nil {
+				t.Fatal(err)
+			}
+			want := strings.Join(tc.want, "\n") + "\n"
+			if got := b.String(); got != want {
+				t.Errorf("got\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
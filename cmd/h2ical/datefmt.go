@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// locale holds the names and the default date pattern of a language as
+// defined by the Unicode CLDR.
+type locale struct {
+	// weekdays and the other arrays start with Sunday and January.
+	weekdays      [7]string
+	abbrWeekdays  [7]string
+	shortWeekdays [7]string
+	months        [12]string
+	abbrMonths    [12]string
+	// standaloneMonths are the month names used without a day, if they
+	// differ from months, e.g. in a calendar title.
+	standaloneMonths [12]string
+	datePattern      string
+	// week labels the ISO week numbers in the grid.
+	week string
+}
+
+// locales contains the languages holidays are translated to. The first one is
+// used for all other languages, in line with the translations.
+var locales = []struct {
+	tag language.Tag
+	locale
+}{
+	{language.German, locale{
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		abbrWeekdays:  [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		shortWeekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		abbrMonths:    [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		datePattern:   "EEE, d. MMM y",
+		week:          "KW",
+	}},
+	{language.English, locale{
+		weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		abbrWeekdays:  [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		shortWeekdays: [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		abbrMonths:    [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		datePattern:   "EEE, MMM d, y",
+	}},
+	{language.French, locale{
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		abbrWeekdays:  [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		shortWeekdays: [7]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+		months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		abbrMonths:    [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		datePattern:   "EEE d MMM y",
+	}},
+	{language.Italian, locale{
+		weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		abbrWeekdays:  [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		shortWeekdays: [7]string{"do", "lu", "ma", "me", "gi", "ve", "sa"},
+		months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		abbrMonths:    [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		datePattern:   "EEE d MMM y",
+	}},
+	{language.Polish, locale{
+		weekdays:         [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		abbrWeekdays:     [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		shortWeekdays:    [7]string{"nd", "pn", "wt", "śr", "cz", "pt", "so"},
+		months:           [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		abbrMonths:       [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		standaloneMonths: [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		datePattern:      "EEE, d MMM y",
+	}},
+	{language.Turkish, locale{
+		weekdays:      [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+		abbrWeekdays:  [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+		shortWeekdays: [7]string{"Pa", "Pt", "Sa", "Ça", "Pe", "Cu", "Ct"},
+		months:        [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+		abbrMonths:    [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+		datePattern:   "d MMM y EEE",
+	}},
+	{language.Spanish, locale{
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		abbrWeekdays:  [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		shortWeekdays: [7]string{"DO", "LU", "MA", "MI", "JU", "VI", "SA"},
+		months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		abbrMonths:    [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		datePattern:   "EEE, d MMM y",
+	}},
+	{language.Dutch, locale{
+		weekdays:      [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		abbrWeekdays:  [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		shortWeekdays: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		months:        [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		abbrMonths:    [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		datePattern:   "EEE d MMM y",
+	}},
+}
+
+var localeMatcher = func() language.Matcher {
+	tags := make([]language.Tag, len(locales))
+	for i, l := range locales {
+		tags[i] = l.tag
+	}
+	return language.NewMatcher(tags)
+}()
+
+// lookupLocale returns the locale closest to the language.
+func lookupLocale(lang language.Tag) locale {
+	_, i, _ := localeMatcher.Match(lang)
+	l := locales[i].locale
+	if l.standaloneMonths[0] == "" {
+		l.standaloneMonths = l.months
+	}
+	if l.week == "" {
+		l.week = "Wk"
+	}
+	return l
+}
+
+// dateField is either a literal or a pattern letter repeated count times.
+type dateField struct {
+	letter  byte
+	count   int
+	literal string
+}
+
+// parseDatePattern parses a date pattern in the syntax of the Unicode CLDR.
+// The supported fields are
+//
+//	d, dd        day of the month
+//	E, EE, EEE   abbreviated weekday, EEEE full weekday, EEEEEE short weekday
+//	M, MM        month number, MMM abbreviated and MMMM full month name
+//	LLLL         full month name without a day
+//	y, yyyy      year, yy two-digit year
+//
+// All other letters are reserved. Text in single quotes is copied as is,
+// with two single quotes standing for one.
+func parseDatePattern(pattern string) ([]dateField, error) {
+	var fields []dateField
+	literal := func(s string) {
+		if n := len(fields); n > 0 && fields[n-1].letter == 0 {
+			fields[n-1].literal += s
+			return
+		}
+		fields = append(fields, dateField{literal: s})
+	}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			if strings.HasPrefix(pattern[i:], "''") {
+				literal("'")
+				i += 2
+				continue
+			}
+			// Within quotes, two single quotes stand for one as well
+			i++
+			for {
+				end := strings.IndexByte(pattern[i:], '\'')
+				if end < 0 {
+					return nil, fmt.Errorf("unterminated quote in date pattern '%s'", pattern)
+				}
+				literal(pattern[i : i+end])
+				i += end + 1
+				if !strings.HasPrefix(pattern[i:], "'") {
+					break
+				}
+				literal("'")
+				i++
+			}
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			if !validDateField(c, count) {
+				return nil, fmt.Errorf("unsupported field '%s' in date pattern '%s'", pattern[i:i+count], pattern)
+			}
+			fields = append(fields, dateField{letter: c, count: count})
+			i += count
+		default:
+			literal(string(c))
+			i++
+		}
+	}
+
+	return fields, nil
+}
+
+func validDateField(letter byte, count int) bool {
+	switch letter {
+	case 'd':
+		return count <= 2
+	case 'E':
+		return count <= 4 || count == 6
+	case 'M':
+		return count <= 4
+	case 'L':
+		return count == 4
+	case 'y':
+		return count <= 4
+	}
+	return false
+}
+
+// dateFormatter formats dates with a pattern and the names of a locale.
+type dateFormatter struct {
+	locale locale
+	fields []dateField
+}
+
+// newDateFormatter returns a formatter for the language. If the pattern is
+// empty, the default pattern of the language is used.
+func newDateFormatter(lang language.Tag, pattern string) (dateFormatter, error) {
+	l := lookupLocale(lang)
+	if pattern == "" {
+		pattern = l.datePattern
+	}
+
+	fields, err := parseDatePattern(pattern)
+	if err != nil {
+		return dateFormatter{}, err
+	}
+	return dateFormatter{locale: l, fields: fields}, nil
+}
+
+func (f dateFormatter) format(t time.Time) string {
+	var b strings.Builder
+
+	for _, field := range f.fields {
+		switch field.letter {
+		case 0:
+			b.WriteString(field.literal)
+		case 'd':
+			fmt.Fprintf(&b, "%0*d", field.count, t.Day())
+		case 'E':
+			switch field.count {
+			case 4:
+				b.WriteString(f.locale.weekdays[t.Weekday()])
+			case 6:
+				b.WriteString(f.locale.shortWeekdays[t.Weekday()])
+			default:
+				b.WriteString(f.locale.abbrWeekdays[t.Weekday()])
+			}
+		case 'M':
+			switch field.count {
+			case 3:
+				b.WriteString(f.locale.abbrMonths[t.Month()-1])
+			case 4:
+				b.WriteString(f.locale.months[t.Month()-1])
+			default:
+				fmt.Fprintf(&b, "%0*d", field.count, int(t.Month()))
+			}
+		case 'L':
+			b.WriteString(f.locale.standaloneMonths[t.Month()-1])
+		case 'y':
+			if field.count == 2 {
+				fmt.Fprintf(&b, "%02d", t.Year()%100)
+			} else {
+				fmt.Fprintf(&b, "%0*d", field.count, t.Year())
+			}
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseDatePattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		want    []dateField
+		wantErr bool
+	}{
+		{"d.M.y", []dateField{{letter: 'd', count: 1}, {literal: "."}, {letter: 'M', count: 1}, {literal: "."}, {letter: 'y', count: 1}}, false},
+		{"EEEE, d. MMMM", []dateField{{letter: 'E', count: 4}, {literal: ", "}, {letter: 'd', count: 1}, {literal: ". "}, {letter: 'M', count: 4}}, false},
+		{"'KW' ww", nil, true},
+		{"d 'de' LLLL", []dateField{{letter: 'd', count: 1}, {literal: " de "}, {letter: 'L', count: 4}}, false},
+		{"'o''clock' d", []dateField{{literal: "o'clock "}, {letter: 'd', count: 1}}, false},
+		{"'''d'''", []dateField{{literal: "'d'"}}, false},
+		{"d''M", []dateField{{letter: 'd', count: 1}, {literal: "'"}, {letter: 'M', count: 1}}, false},
+		{"''", []dateField{{literal: "'"}}, false},
+		{"", nil, false},
+		{"'day d", nil, true},
+		{"ddd", nil, true},
+		{"EEEEE", nil, true},
+		{"MMMMM", nil, true},
+		{"LLL", nil, true},
+		{"yyyyy", nil, true},
+		{"HH:mm", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			got, err := parseDatePattern(tc.pattern)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v; want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDateFormatter(t *testing.T) {
+	testCases := []struct {
+		lang    language.Tag
+		pattern string
+		want    string
+	}{
+		{language.German, "", "Mo., 5. Mai 2025"},
+		{language.English, "", "Mon, May 5, 2025"},
+		{language.French, "", "lun. 5 mai 2025"},
+		{language.German, "dd.MM.yy", "05.05.25"},
+		{language.German, "EEEE, d. MMMM y", "Montag, 5. Mai 2025"},
+		{language.English, "EEEEEE d MMM yyyy", "Mo 5 May 2025"},
+		{language.English, "'Week of' d''MM", "Week of 5'05"},
+		{language.English, "d 'o''clock'", "5 o'clock"},
+		{language.Make("sv"), "", "Mo., 5. Mai 2025"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.lang.String()+" "+tc.pattern, func(t *testing.T) {
+			f, err := newDateFormatter(tc.lang, tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.format(date(2025, 5, 5)); got != tc.want {
+				t.Errorf("got %s; want %s", got, tc.want)
+			}
+		})
+	}
+}
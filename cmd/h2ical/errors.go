@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+// Exit statuses of h2ical.
+const (
+	exitOK = 0
+	// exitFailure is used for errors at runtime, e.g. files that can't be
+	// read or written.
+	exitFailure = 1
+	// exitUsage is used for invalid commands, flags and arguments.
+	exitUsage = 2
+	// exitInvalid is used if holidays can't be generated or the definitions
+	// contain errors.
+	exitInvalid = 3
+)
+
+// errorList collects errors to report all of them at once.
+type errorList []error
+
+func (l *errorList) add(err error) {
+	if err != nil {
+		*l = append(*l, err)
+	}
+}
+
+func (l errorList) Error() string {
+	messages := make([]string, len(l))
+	for i, err := range l {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// usageError is an error in the flags or arguments of a command.
+type usageError struct{ error }
+
+func (e usageError) Unwrap() error { return e.error }
+
+// invalidError is an error in the holidays or their definitions.
+type invalidError struct{ error }
+
+func (e invalidError) Unwrap() error { return e.error }
+
+// asUsageError returns the collected errors as usage error or nil if there
+// are none.
+func (l errorList) asUsageError() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return usageError{l}
+}
+
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+	if errors.As(err, &usageError{}) {
+		return exitUsage
+	}
+	if errors.As(err, &invalidError{}) {
+		return exitInvalid
+	}
+	return exitFailure
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+var calendarName = holidays.TranslatedString{
+	language.German:  "Feiertage",
+	language.English: "Holidays",
+}
+
+// uidNamespace is the namespace of the name-based UUIDs of the events.
+var uidNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/kevinmorio/holidays2ical"))
+
+// productID is the PRODID of the calendars.
+const productID = "-//Kevin Morio//holidays2ics"
+
+// kindEventColors are the RFC 7986 COLOR of the events by kind of holiday
+// as CSS color names, matching the colors of the grid.
+var kindEventColors = map[holidays.Kind]string{
+	holidays.PublicHoliday:         "red",
+	holidays.RegionalPublicHoliday: "darkmagenta",
+	holidays.Commemoration:         "blue",
+	holidays.Observance:            "green",
+	holidays.ClockChange:           "darkcyan",
+}
+
+// calendar is the format independent form of a generated calendar that the
+// ics, jcal and xcal formats are written from.
+type calendar struct {
+	name   string
+	events []event
+}
+
+// event is an all-day event of a holiday.
+type event struct {
+	uid       string
+	timestamp time.Time
+	// start is the date of the holiday and end the day after, as DTEND is
+	// exclusive.
+	start       time.Time
+	end         time.Time
+	summary     string
+	description string
+	// busy events block the time in schedules and mark people as out of
+	// office.
+	busy     bool
+	category string
+	color    string
+	alarms   []alarm
+}
+
+// eventOptions configure the events of the holidays.
+type eventOptions struct {
+	// timestamp is the DTSTAMP of all events.
+	timestamp time.Time
+	alarms    alarmFlag
+	// busy overrides whether the events of the holidays with the given IDs
+	// are busy.
+	busy map[string]bool
+}
+
+// isBusy reports whether the holiday is a day off, i.e. a public holiday in
+// the region or, without a region, nationwide, unless it is overridden.
+func (opts eventOptions) isBusy(h *holidays.Holiday, region holidays.Region) bool {
+	if busy, ok := opts.busy[h.ID]; ok {
+		return busy
+	}
+	if !h.Kind.IsPublic() {
+		return false
+	}
+	if region == "" {
+		return h.Nationwide
+	}
+	return h.AppliesTo(region)
+}
+
+// busyFlags are the flags overriding which holidays are busy.
+type busyFlags struct {
+	busy string
+	free string
+}
+
+func (f *busyFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.busy, "busy", "", "comma-separated IDs of holidays to mark as busy regardless of their kind, e.g. christmas-eve")
+	flags.StringVar(&f.free, "free", "", "comma-separated IDs of holidays to mark as free regardless of their kind")
+}
+
+// parse returns the overrides by holiday ID. It must be called after the
+// definitions have been loaded, so that their IDs are known.
+func (f busyFlags) parse() (map[string]bool, error) {
+	overrides := map[string]bool{}
+	var errs errorList
+
+	for _, list := range []struct {
+		ids  string
+		busy bool
+	}{{f.busy, true}, {f.free, false}} {
+		if list.ids == "" {
+			continue
+		}
+		for _, id := range strings.Split(list.ids, ",") {
+			id = strings.TrimSpace(id)
+			if _, ok := holidays.Builtin().Lookup(id); !ok {
+				errs.add(fmt.Errorf("unknown holiday '%s'", id))
+				continue
+			}
+			if busy, ok := overrides[id]; ok && busy != list.busy {
+				errs.add(fmt.Errorf("holiday '%s' is both busy and free", id))
+				continue
+			}
+			overrides[id] = list.busy
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return overrides, nil
+}
+
+// eventUID derives a stable UID from the holiday, its date and kind and the
+// region the calendar is generated for, so that regenerated calendars update
+// existing events instead of duplicating them. The kind distinguishes the
+// events of a holiday observed differently in different regions.
+func eventUID(h *holidays.Holiday, region holidays.Region) string {
+	name := fmt.Sprintf("%s/%s/%s/%s", h.ID, h.Date.Format("2006-01-02"), h.Kind, region)
+	return strings.ToUpper(uuid.NewSHA1(uidNamespace, []byte(name)).String())
+}
+
+func holidayToEvent(h *holidays.Holiday, lang language.Tag, region holidays.Region, opts eventOptions) (event, error) {
+	// Consider event name as required
+	hName, ok := h.Name.Lookup(lang)
+	if !ok {
+		return event{}, fmt.Errorf("%s: name not available for language '%s'", h.ID, lang)
+	}
+
+	// Description is optional
+	hDescription := h.Description.Translate(lang)
+
+	dates, err := newDateFormatter(lang, "")
+	if err != nil {
+		return event{}, err
+	}
+
+	return event{
+		uid:         eventUID(h, region),
+		timestamp:   opts.timestamp.UTC(),
+		start:       h.Date.UTC(),
+		end:         h.Date.AddDate(0, 0, 1).UTC(),
+		summary:     hName,
+		description: hDescription,
+		busy:        opts.isBusy(h, region),
+		category:    h.Kind.Name().Translate(lang),
+		color:       kindEventColors[h.Kind],
+		alarms:      opts.alarms.alarms(h, hName, dates.format(h.Date)),
+	}, nil
+}
+
+// newCalendar returns a calendar with an event for each of the holidays. If
+// any of the events can't be created, all errors are returned instead.
+func newCalendar(hs []holidays.Holiday, s selection, opts eventOptions) (calendar, error) {
+	var errs errorList
+	cal := calendar{name: calendarName.Translate(s.lang)}
+
+	for _, holiday := range hs {
+		e, err := holidayToEvent(&holiday, s.lang, s.region, opts)
+		if err != nil {
+			errs.add(fmt.Errorf("couldn't create event: %w", err))
+			continue
+		}
+		cal.events = append(cal.events, e)
+	}
+
+	if len(errs) > 0 {
+		return calendar{}, invalidError{errs}
+	}
+	return cal, nil
+}
+
+// busyStatus returns the X-MICROSOFT-CDO-BUSYSTATUS of the event.
+func (e event) busyStatus() string {
+	if e.busy {
+		return "OOF"
+	}
+	return "FREE"
+}
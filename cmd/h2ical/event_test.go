@@ -0,0 +1,115 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+func TestEventUID(t *testing.T) {
+	newYear := holidays.Holiday{ID: "new-year", Date: date(2025, 1, 1), Kind: holidays.PublicHoliday}
+
+	// The UIDs must never change, as calendar clients would duplicate the
+	// events otherwise
+	stable := []struct {
+		region holidays.Region
+		want   string
+	}{
+		{"", "F68B9EAF-947B-53B2-8793-3DD90603CC7F"},
+		{holidays.Bayern, "0EA12D3F-24A3-5F40-B3BB-D1CDE00C6AD1"},
+	}
+	for _, tc := range stable {
+		if got := eventUID(&newYear, tc.region); got != tc.want {
+			t.Errorf("%s: got %s; want %s", tc.region, got, tc.want)
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		holiday holidays.Holiday
+		region  holidays.Region
+	}{
+		{"region", newYear, holidays.Berlin},
+		{"date", holidays.Holiday{ID: "new-year", Date: date(2026, 1, 1), Kind: holidays.PublicHoliday}, ""},
+		{"kind", holidays.Holiday{ID: "new-year", Date: date(2025, 1, 1), Kind: holidays.Observance}, ""},
+		{"ID", holidays.Holiday{ID: "silvester", Date: date(2025, 1, 1), Kind: holidays.PublicHoliday}, ""},
+	}
+	seen := map[string]string{}
+	for _, tc := range stable {
+		seen[tc.want] = string(tc.region)
+	}
+	for _, tc := range testCases {
+		got := eventUID(&tc.holiday, tc.region)
+		if other, ok := seen[got]; ok {
+			t.Errorf("%s: same UID %s as %q", tc.name, got, other)
+		}
+		seen[got] = tc.name
+	}
+}
+
+func TestIsBusy(t *testing.T) {
+	public := holidays.Holiday{ID: "new-year", Kind: holidays.PublicHoliday, Nationwide: true}
+	regional := holidays.Holiday{ID: "corpus-christi", Kind: holidays.RegionalPublicHoliday, Regions: []holidays.Region{holidays.Bayern, holidays.Hessen}}
+	commemoration := holidays.Holiday{ID: "womens-day", Kind: holidays.Commemoration, Regions: []holidays.Region{holidays.Bayern}}
+	observance := holidays.Holiday{ID: "christmas-eve", Kind: holidays.Observance, Nationwide: true}
+
+	opts := eventOptions{busy: map[string]bool{"christmas-eve": true, "corpus-christi": false}}
+
+	testCases := []struct {
+		name    string
+		holiday holidays.Holiday
+		region  holidays.Region
+		opts    eventOptions
+		want    bool
+	}{
+		{"public", public, "", eventOptions{}, true},
+		{"public in region", public, holidays.Hamburg, eventOptions{}, true},
+		{"regional without region", regional, "", eventOptions{}, false},
+		{"regional in region", regional, holidays.Bayern, eventOptions{}, true},
+		{"regional in other region", regional, holidays.Hamburg, eventOptions{}, false},
+		{"commemoration in region", commemoration, holidays.Bayern, eventOptions{}, false},
+		{"observance", observance, "", eventOptions{}, false},
+		{"observance marked busy", observance, "", opts, true},
+		{"regional marked free", regional, holidays.Bayern, opts, false},
+		{"public not overridden", public, "", opts, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.opts.isBusy(&tc.holiday, tc.region); got != tc.want {
+				t.Errorf("got %t; want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBusyFlags(t *testing.T) {
+	testCases := []struct {
+		busy    string
+		free    string
+		want    map[string]bool
+		wantErr bool
+	}{
+		{"", "", map[string]bool{}, false},
+		{"christmas-eve, silvester", "", map[string]bool{"christmas-eve": true, "silvester": true}, false},
+		{"christmas-eve", "first-christmas-day", map[string]bool{"christmas-eve": true, "first-christmas-day": false}, false},
+		{"christmas-eve,christmas-eve", "", map[string]bool{"christmas-eve": true}, false},
+		{"christmas-eve", "christmas-eve", nil, true},
+		{"boxing-day", "", nil, true},
+		{"", "unknown", nil, true},
+		{"christmas-eve,", "", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.busy+"/"+tc.free, func(t *testing.T) {
+			got, err := busyFlags{busy: tc.busy, free: tc.free}.parse()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
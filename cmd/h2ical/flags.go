@@ -0,0 +1,290 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+// selectionFlags are the flags shared by the commands that select holidays.
+type selectionFlags struct {
+	lang   string
+	region string
+	kinds  string
+	defs   string
+}
+
+func (f *selectionFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.lang, "lang", "de", "the language used for the holidays")
+	flags.StringVar(&f.region, "region", "", "only include holidays of the given state, e.g. DE-BY")
+	flags.StringVar(&f.kinds, "kinds", "public,regional,commemoration,observance,clock-change", "comma-separated list of the holiday kinds to include")
+	flags.StringVar(&f.defs, "defs", "", "YAML or JSON file with additional holiday definitions")
+}
+
+// selection is the parsed form of the selection flags.
+type selection struct {
+	lang   language.Tag
+	region holidays.Region
+	kinds  map[holidays.Kind]bool
+}
+
+// parse checks the flags and adds any errors to errs.
+func (f *selectionFlags) parse(errs *errorList) selection {
+	var s selection
+	var err error
+
+	s.lang, err = language.Parse(f.lang)
+	if err != nil {
+		errs.add(fmt.Errorf("invalid language tag '%s'", f.lang))
+	}
+
+	s.kinds, err = parseKinds(f.kinds)
+	errs.add(err)
+
+	if f.region != "" {
+		s.region, err = holidays.ParseRegion(f.region)
+		errs.add(err)
+	}
+
+	return s
+}
+
+// load registers the additional holiday definitions.
+func (f *selectionFlags) load() error {
+	if f.defs == "" {
+		return nil
+	}
+	return loadDefinitions(f.defs)
+}
+
+// filters returns the filters selecting the holidays of the region and kinds.
+func (s selection) filters() []holidays.Filter {
+	filters := []holidays.Filter{func(h holidays.Holiday) bool {
+		return s.kinds[h.Kind]
+	}}
+	if s.region != "" {
+		filters = append(filters, holidays.InRegion(s.region))
+	}
+	return filters
+}
+
+// holidaysBetween returns the selected holidays from the date start up to
+// and including the date end.
+func (s selection) holidaysBetween(start, end time.Time) []holidays.Holiday {
+	return holidays.HolidaysBetween(start, end, s.filters()...)
+}
+
+// rangeFlags select the dates holidays are included from, either by whole
+// years, by dates or relative to today.
+type rangeFlags struct {
+	from   int
+	till   int
+	start  string
+	end    string
+	window string
+	// explicit contains the names of the flags that were set.
+	explicit map[string]bool
+}
+
+func (f *rangeFlags) register(flags *flag.FlagSet) {
+	flags.IntVar(&f.from, "from", 0, "year to start from (default the current year)")
+	flags.IntVar(&f.till, "till", 0, "year to end (default the current year)")
+	flags.StringVar(&f.start, "start", "", "first date as YYYY-MM-DD instead of the start of -from")
+	flags.StringVar(&f.end, "end", "", "last date as YYYY-MM-DD instead of the end of -till")
+	flags.StringVar(&f.window, "window", "", "dates relative to today instead of years, e.g. -3m:+24m (units d, w, m, y)")
+}
+
+// visit records which of the flags were set.
+func (f *rangeFlags) visit(flags *flag.FlagSet) {
+	f.explicit = map[string]bool{}
+	flags.Visit(func(fl *flag.Flag) {
+		f.explicit[fl.Name] = true
+	})
+}
+
+// minYear and maxYear bound the range, as dates are written with four-digit
+// years.
+const (
+	minYear = 1
+	maxYear = 9999
+)
+
+// parse returns the first and the last date of the range and adds any errors
+// to errs. -from and -till default to the year of today, so that a server
+// running for long keeps up with the years.
+func (f *rangeFlags) parse(today time.Time, errs *errorList) (time.Time, time.Time) {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	n := len(*errs)
+	from, till := f.from, f.till
+	if !f.explicit["from"] {
+		from = today.Year()
+	}
+	if !f.explicit["till"] {
+		till = today.Year()
+	}
+	checkYear := func(name string, year int) {
+		if year < minYear || year > maxYear {
+			errs.add(fmt.Errorf("-%s %d is not between %d and %d", name, year, minYear, maxYear))
+		}
+	}
+	checkYear("from", from)
+	checkYear("till", till)
+	start := time.Date(from, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(till, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	parseDate := func(name, value string) time.Time {
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			errs.add(fmt.Errorf("invalid -%s '%s'", name, value))
+		}
+		return date
+	}
+
+	switch {
+	case f.window != "":
+		if f.explicit["start"] || f.explicit["end"] || f.explicit["from"] || f.explicit["till"] {
+			errs.add(fmt.Errorf("-window can't be combined with -start, -end, -from or -till"))
+			break
+		}
+		var err error
+		start, end, err = parseWindow(f.window, today)
+		errs.add(err)
+	default:
+		if f.start != "" {
+			if f.explicit["from"] {
+				errs.add(fmt.Errorf("-start can't be combined with -from"))
+			}
+			start = parseDate("start", f.start)
+		}
+		if f.end != "" {
+			if f.explicit["till"] {
+				errs.add(fmt.Errorf("-end can't be combined with -till"))
+			}
+			end = parseDate("end", f.end)
+		}
+	}
+
+	if len(*errs) > n {
+		return start, end
+	}
+	if start.Year() < minYear || end.Year() > maxYear {
+		errs.add(fmt.Errorf("the range from %s to %s isn't within the years %d to %d", start.Format("2006-01-02"), end.Format("2006-01-02"), minYear, maxYear))
+	} else if start.After(end) {
+		errs.add(fmt.Errorf("the range starts on %s after it ends on %s", start.Format("2006-01-02"), end.Format("2006-01-02")))
+	}
+	return start, end
+}
+
+// parseWindow returns the dates of a window like -3m:+24m relative to today.
+func parseWindow(s string, today time.Time) (time.Time, time.Time, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid window '%s', expected e.g. -3m:+24m", s)
+	}
+
+	var dates [2]time.Time
+	for i, part := range parts {
+		date, err := addOffset(today, part)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid window '%s': %w", s, err)
+		}
+		dates[i] = date
+	}
+	return dates[0], dates[1], nil
+}
+
+// addOffset adds an offset like +2w to the date. The units are days, weeks,
+// months and years; an offset of 0 needs no unit. Months and years are
+// clamped to the end of the month.
+func addOffset(date time.Time, offset string) (time.Time, error) {
+	if offset == "0" {
+		return date, nil
+	}
+	if len(offset) < 2 {
+		return time.Time{}, fmt.Errorf("invalid offset '%s'", offset)
+	}
+
+	n, err := strconv.Atoi(offset[:len(offset)-1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid offset '%s'", offset)
+	}
+	switch offset[len(offset)-1] {
+	case 'd':
+		return date.AddDate(0, 0, n), nil
+	case 'w':
+		return date.AddDate(0, 0, 7*n), nil
+	case 'm':
+		return addMonths(date, n), nil
+	case 'y':
+		return addMonths(date, 12*n), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid unit of offset '%s'", offset)
+	}
+}
+
+// addMonths adds n months to the date. Unlike time.AddDate, it keeps the
+// date in the resulting month, e.g. one month before March 31 is the end
+// of February instead of March 3.
+func addMonths(date time.Time, n int) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(n), 1, 0, 0, 0, 0, date.Location())
+	days := first.AddDate(0, 1, -1).Day()
+	day := date.Day()
+	if day > days {
+		day = days
+	}
+	return time.Date(first.Year(), first.Month(), day, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
+
+// loadDefinitions adds the holiday definitions in the file to the built-in
+// ones.
+func loadDefinitions(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	definitions, err := holidays.LoadDefinitions(f)
+	if err != nil {
+		return invalidError{fmt.Errorf("%s: %w", path, err)}
+	}
+
+	if err := holidays.Register(definitions...); err != nil {
+		return invalidError{fmt.Errorf("%s: %w", path, err)}
+	}
+	return nil
+}
+
+func parseKinds(s string) (map[holidays.Kind]bool, error) {
+	kinds := map[holidays.Kind]bool{}
+	var errs errorList
+
+	for _, name := range strings.Split(s, ",") {
+		kind, err := holidays.ParseKind(strings.TrimSpace(name))
+		if err != nil {
+			errs.add(err)
+			continue
+		}
+		kinds[kind] = true
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return kinds, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
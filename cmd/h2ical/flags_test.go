@@ -0,0 +1,139 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAddOffset(t *testing.T) {
+	testCases := []struct {
+		date    time.Time
+		offset  string
+		want    time.Time
+		wantErr bool
+	}{
+		{date(2025, 3, 31), "0", date(2025, 3, 31), false},
+		{date(2025, 3, 31), "+1d", date(2025, 4, 1), false},
+		{date(2025, 3, 31), "-10d", date(2025, 3, 21), false},
+		{date(2025, 3, 31), "+2w", date(2025, 4, 14), false},
+		{date(2025, 3, 31), "-1m", date(2025, 2, 28), false},
+		{date(2024, 3, 31), "-1m", date(2024, 2, 29), false},
+		{date(2025, 1, 31), "+1m", date(2025, 2, 28), false},
+		{date(2025, 3, 31), "+1m", date(2025, 4, 30), false},
+		{date(2025, 3, 15), "-3m", date(2024, 12, 15), false},
+		{date(2025, 3, 15), "+24m", date(2027, 3, 15), false},
+		{date(2024, 2, 29), "+1y", date(2025, 2, 28), false},
+		{date(2024, 2, 29), "-4y", date(2020, 2, 29), false},
+		{date(2025, 3, 31), "1", time.Time{}, true},
+		{date(2025, 3, 31), "m", time.Time{}, true},
+		{date(2025, 3, 31), "+1x", time.Time{}, true},
+		{date(2025, 3, 31), "+am", time.Time{}, true},
+		{date(2025, 3, 31), "", time.Time{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date.Format("2006-01-02")+" "+tc.offset, func(t *testing.T) {
+			got, err := addOffset(tc.date, tc.offset)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %s; want %s", got.Format("2006-01-02"), tc.want.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	today := date(2025, 5, 31)
+
+	testCases := []struct {
+		window    string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{"-3m:+24m", date(2025, 2, 28), date(2027, 5, 31), false},
+		{"0:+1y", date(2025, 5, 31), date(2026, 5, 31), false},
+		{"-1w:0", date(2025, 5, 24), date(2025, 5, 31), false},
+		{"+1d:+1m", date(2025, 6, 1), date(2025, 6, 30), false},
+		{"-3m", time.Time{}, time.Time{}, true},
+		{"-3m:+1m:+2m", time.Time{}, time.Time{}, true},
+		{"-3m:soon", time.Time{}, time.Time{}, true},
+		{"", time.Time{}, time.Time{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.window, func(t *testing.T) {
+			start, end, err := parseWindow(tc.window, today)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tc.wantErr)
+			}
+			if !start.Equal(tc.wantStart) || !end.Equal(tc.wantEnd) {
+				t.Errorf("got %s to %s; want %s to %s",
+					start.Format("2006-01-02"), end.Format("2006-01-02"),
+					tc.wantStart.Format("2006-01-02"), tc.wantEnd.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestRangeFlags(t *testing.T) {
+	today := date(2025, 5, 31)
+
+	testCases := []struct {
+		args      []string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{nil, date(2025, 1, 1), date(2025, 12, 31), false},
+		{[]string{"-from", "2024", "-till", "2026"}, date(2024, 1, 1), date(2026, 12, 31), false},
+		{[]string{"-till", "2027"}, date(2025, 1, 1), date(2027, 12, 31), false},
+		{[]string{"-start", "2025-09-01", "-end", "2026-08-31"}, date(2025, 9, 1), date(2026, 8, 31), false},
+		{[]string{"-window", "-3m:+24m"}, date(2025, 2, 28), date(2027, 5, 31), false},
+		{[]string{"-from", "1", "-till", "9999"}, date(1, 1, 1), date(9999, 12, 31), false},
+		{[]string{"-from", "0"}, time.Time{}, time.Time{}, true},
+		{[]string{"-from", "-5"}, time.Time{}, time.Time{}, true},
+		{[]string{"-till", "10000"}, time.Time{}, time.Time{}, true},
+		{[]string{"-from", "9223372036854775807", "-till", "9223372036854775807"}, time.Time{}, time.Time{}, true},
+		{[]string{"-start", "0000-12-31"}, time.Time{}, time.Time{}, true},
+		{[]string{"-window", "0:+9000y"}, time.Time{}, time.Time{}, true},
+		{[]string{"-from", "2026", "-till", "2025"}, time.Time{}, time.Time{}, true},
+		{[]string{"-start", "2025-13-01"}, time.Time{}, time.Time{}, true},
+		{[]string{"-window", "-3m:+24m", "-from", "2025"}, time.Time{}, time.Time{}, true},
+		{[]string{"-start", "2025-09-01", "-from", "2025"}, time.Time{}, time.Time{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.args), func(t *testing.T) {
+			var f rangeFlags
+			flags := flag.NewFlagSet("test", flag.ContinueOnError)
+			f.register(flags)
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatal(err)
+			}
+			f.visit(flags)
+
+			var errs errorList
+			start, end := f.parse(today, &errs)
+			if (len(errs) > 0) != tc.wantErr {
+				t.Fatalf("got errors %v; want errors %t", errs, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !start.Equal(tc.wantStart) || !end.Equal(tc.wantEnd) {
+				t.Errorf("got %s to %s; want %s to %s",
+					start.Format("2006-01-02"), end.Format("2006-01-02"),
+					tc.wantStart.Format("2006-01-02"), tc.wantEnd.Format("2006-01-02"))
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ICSFormat  string = "ics"
+	JCalFormat string = "jcal"
+	XCalFormat string = "xcal"
+	JSONFormat string = "json"
+	CSVFormat  string = "csv"
+	TSVFormat  string = "tsv"
+)
+
+// formats lists the output formats of generate.
+var formats = []string{ICSFormat, JCalFormat, XCalFormat, JSONFormat, CSVFormat, TSVFormat}
+
+// parseTimestamp returns the time used for the DTSTAMP of all events. The
+// value is either a Unix timestamp or a RFC 3339 date. If it is empty,
+// SOURCE_DATE_EPOCH is used and the current time if that is unset as well.
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		value = os.Getenv("SOURCE_DATE_EPOCH")
+	}
+	if value == "" {
+		return time.Now(), nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp '%s'", value)
+	}
+
+	return timestamp, nil
+}
+
+func generate(flags *flag.FlagSet, args []string) error {
+	var sf selectionFlags
+	var rf rangeFlags
+	sf.register(flags)
+	rf.register(flags)
+	format := flags.String("format", ICSFormat, "the output format of the calendar ("+strings.Join(formats, "|")+")")
+	outfilePath := flags.String("outfile", "", "the outfile of the calendar or - for the standard output (default Holidays.<format>)")
+	columnList := flags.String("columns", defaultCSVColumns, "comma-separated list of the columns of the csv and tsv formats ("+strings.Join(csvColumns, "|")+")")
+	var opts eventOptions
+	var bf busyFlags
+	bf.register(flags)
+	opts.alarms.register(flags)
+	timestampValue := flags.String("timestamp", "", "the DTSTAMP of the events as Unix time or RFC 3339 date (default $SOURCE_DATE_EPOCH or now)")
+	flags.Parse(args)
+
+	var errs errorList
+	if flags.NArg() > 0 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " ")))
+	}
+	s := sf.parse(&errs)
+	rf.visit(flags)
+	start, end := rf.parse(time.Now(), &errs)
+	timestamp, err := parseTimestamp(*timestampValue)
+	errs.add(err)
+	opts.timestamp = timestamp
+	if !contains(formats, *format) {
+		errs.add(fmt.Errorf("invalid format '%s'", *format))
+	}
+	columns, err := parseColumns(*columnList)
+	errs.add(err)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+
+	if err := sf.load(); err != nil {
+		return err
+	}
+	opts.busy, err = bf.parse()
+	if err != nil {
+		return usageError{err}
+	}
+
+	// Create the calendar before the file, so that an existing file isn't
+	// replaced if the calendar can't be generated
+	hs := s.holidaysBetween(start, end)
+	var write func(io.Writer) error
+	switch *format {
+	case ICSFormat, JCalFormat, XCalFormat:
+		cal, err := newCalendar(hs, s, opts)
+		if err != nil {
+			return err
+		}
+		write = map[string]func(io.Writer) error{
+			ICSFormat:  cal.writeICS,
+			JCalFormat: cal.writeJCal,
+			XCalFormat: cal.writeXCal,
+		}[*format]
+	case JSONFormat:
+		write = func(w io.Writer) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(hs)
+		}
+	case CSVFormat:
+		write = func(w io.Writer) error {
+			return writeCSV(w, hs, s, columns, ',')
+		}
+	case TSVFormat:
+		write = func(w io.Writer) error {
+			return writeCSV(w, hs, s, columns, '\t')
+		}
+	}
+
+	if *outfilePath == "" {
+		*outfilePath = "Holidays." + *format
+	}
+
+	if *outfilePath == "-" {
+		return write(os.Stdout)
+	}
+
+	outfile, err := os.Create(*outfilePath)
+	if err != nil {
+		return err
+	}
+	if err := write(outfile); err != nil {
+		outfile.Close()
+		return err
+	}
+	if err := outfile.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved calendar to %s\n", *outfilePath)
+	return nil
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+// kindColors are the colors holidays are highlighted with in the grid.
+var kindColors = map[holidays.Kind]*color.Color{
+	holidays.PublicHoliday:         color.New(color.FgRed, color.Bold),
+	holidays.RegionalPublicHoliday: color.New(color.FgMagenta, color.Bold),
+	holidays.Commemoration:         color.New(color.FgBlue),
+	holidays.Observance:            color.New(color.FgGreen),
+	holidays.ClockChange:           color.New(color.FgCyan),
+}
+
+// holidayMarker follows the days of holidays if colors are disabled, e.g.
+// because the output isn't a terminal or NO_COLOR is set.
+const holidayMarker = "*"
+
+// gridFlags configure the layout of the month grids.
+type gridFlags struct {
+	firstWeekday string
+	weekNumbers  bool
+}
+
+func (f *gridFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.firstWeekday, "first-weekday", "monday", "the weekday the weeks of the grid start with")
+	flags.BoolVar(&f.weekNumbers, "week-numbers", true, "show ISO week numbers in the grid")
+}
+
+func (f *gridFlags) parse(errs *errorList) grid {
+	g := grid{weekNumbers: f.weekNumbers}
+	weekday, err := holidays.ParseWeekday(f.firstWeekday)
+	errs.add(err)
+	g.firstWeekday = weekday
+	return g
+}
+
+// grid renders months like cal(1) with the holidays highlighted by kind and
+// listed in a legend below each month.
+type grid struct {
+	selection
+	firstWeekday time.Weekday
+	weekNumbers  bool
+}
+
+// width returns the width of a month without escape sequences. Each day
+// takes three characters including the space or marker after it.
+func (g grid) width() int {
+	if g.weekNumbers {
+		return 24
+	}
+	return 21
+}
+
+// month returns the lines of the month grid and its legend, each padded to
+// the width of the grid.
+func (g grid) month(year int, month time.Month, hs []holidays.Holiday) []string {
+	byDay := map[int][]holidays.Holiday{}
+	for _, h := range hs {
+		if h.Date.Year() == year && h.Date.Month() == month {
+			byDay[h.Date.Day()] = append(byDay[h.Date.Day()], h)
+		}
+	}
+
+	l := lookupLocale(g.lang)
+	title := fmt.Sprintf("%s %d", l.standaloneMonths[month-1], year)
+	lines := []string{center(title, g.width())}
+
+	var header []string
+	if g.weekNumbers {
+		header = append(header, pad(l.week, 2))
+	}
+	for i := 0; i < 7; i++ {
+		header = append(header, pad(l.shortWeekdays[(g.firstWeekday+time.Weekday(i))%7], 2))
+	}
+	lines = append(lines, pad(strings.Join(header, " "), g.width()))
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	start := first.AddDate(0, 0, -int((first.Weekday()-g.firstWeekday+7)%7))
+	for week := start; week.Month() == month || week.Before(first); week = week.AddDate(0, 0, 7) {
+		var line strings.Builder
+		if g.weekNumbers {
+			// Every week contains exactly one Thursday, which determines
+			// the ISO week
+			thursday := week.AddDate(0, 0, int((time.Thursday-g.firstWeekday+7)%7))
+			_, isoWeek := thursday.ISOWeek()
+			fmt.Fprintf(&line, "%2d ", isoWeek)
+		}
+		for i := 0; i < 7; i++ {
+			date := week.AddDate(0, 0, i)
+			separator := " "
+			if date.Month() != month {
+				line.WriteString("   ")
+				continue
+			}
+
+			day := fmt.Sprintf("%2d", date.Day())
+			if hs, ok := byDay[date.Day()]; ok {
+				day = kindColor(hs).Sprint(day)
+				if color.NoColor {
+					separator = holidayMarker
+				}
+			}
+			line.WriteString(day + separator)
+		}
+		lines = append(lines, line.String())
+	}
+
+	for day := 1; day <= 31; day++ {
+		for _, h := range byDay[day] {
+			prefix := kindColors[h.Kind].Sprintf("%2d", day)
+			for i, text := range wrap(h.Name.Translate(g.lang), g.width()-3) {
+				if i > 0 {
+					prefix = "  "
+				}
+				lines = append(lines, prefix+" "+pad(text, g.width()-3))
+			}
+		}
+	}
+
+	return lines
+}
+
+// year returns the lines of the months of the year from first to last in
+// rows of three.
+func (g grid) year(year int, first, last time.Month, hs []holidays.Holiday) []string {
+	const gap = "  "
+	lines := []string{center(strconv.Itoa(year), 3*g.width()+2*len(gap)), ""}
+
+	for row := first; row <= last; row += 3 {
+		var months [][]string
+		height := 0
+		for month := row; month < row+3 && month <= last; month++ {
+			m := g.month(year, month, hs)
+			months = append(months, m)
+			if len(m) > height {
+				height = len(m)
+			}
+		}
+
+		for i := 0; i < height; i++ {
+			var columns []string
+			for _, m := range months {
+				if i < len(m) {
+					columns = append(columns, m[i])
+				} else {
+					columns = append(columns, strings.Repeat(" ", g.width()))
+				}
+			}
+			lines = append(lines, strings.TrimRight(strings.Join(columns, gap), " "))
+		}
+		lines = append(lines, "")
+	}
+
+	return lines
+}
+
+// key returns a line explaining the highlighting of the holidays in the
+// language of the grid.
+func (g grid) key() string {
+	if color.NoColor {
+		return holidayMarker + " " + calendarName.Translate(g.lang)
+	}
+
+	var names []string
+	for _, kind := range holidays.AllKinds {
+		if g.kinds[kind] {
+			names = append(names, kindColors[kind].Sprint(kind.Name().Translate(g.lang)))
+		}
+	}
+	return strings.Join(names, "  ")
+}
+
+// holidaysOfYear returns the selected holidays of the year.
+func (g grid) holidaysOfYear(year int) []holidays.Holiday {
+	return g.holidaysBetween(
+		time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+	)
+}
+
+func (g grid) printYear(year int) {
+	hs := g.holidaysOfYear(year)
+	for _, line := range g.year(year, time.January, time.December, hs) {
+		fmt.Println(line)
+	}
+	fmt.Println(g.key())
+}
+
+// printRange prints the months from the date start to the date end grouped
+// by year. Only the holidays within the range are highlighted.
+func (g grid) printRange(start, end time.Time) {
+	hs := g.holidaysBetween(start, end)
+	for year := start.Year(); year <= end.Year(); year++ {
+		first, last := time.January, time.December
+		if year == start.Year() {
+			first = start.Month()
+		}
+		if year == end.Year() {
+			last = end.Month()
+		}
+		for _, line := range g.year(year, first, last, hs) {
+			fmt.Println(line)
+		}
+		fmt.Println(g.key())
+	}
+}
+
+func (g grid) printMonth(year int, month time.Month) {
+	hs := g.holidaysOfYear(year)
+	for _, line := range g.month(year, month, hs) {
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+	fmt.Println()
+	fmt.Println(g.key())
+}
+
+// kindColor returns the color of the most significant kind of the holidays
+// in the order of holidays.AllKinds.
+func kindColor(hs []holidays.Holiday) *color.Color {
+	for _, kind := range holidays.AllKinds {
+		for _, h := range hs {
+			if h.Kind == kind {
+				return kindColors[kind]
+			}
+		}
+	}
+	return color.New()
+}
+
+func center(s string, width int) string {
+	n := utf8.RuneCountInString(s)
+	if n >= width {
+		return s
+	}
+	left := (width - n) / 2
+	return strings.Repeat(" ", left) + s + strings.Repeat(" ", width-n-left)
+}
+
+func pad(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// wrap breaks the text into lines of at most width characters at spaces.
+// Words longer than width are kept whole.
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	return append(lines, line)
+}
+
+func cal(flags *flag.FlagSet, args []string) error {
+	var sf selectionFlags
+	var gf gridFlags
+	sf.register(flags)
+	gf.register(flags)
+	args = parseInterspersed(flags, args)
+
+	var errs errorList
+	year, month := time.Now().Year(), time.Month(0)
+	if len(args) > 2 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(args[2:], " ")))
+	}
+	if len(args) > 0 {
+		y, err := strconv.Atoi(args[0])
+		if err != nil || y < 1 {
+			errs.add(fmt.Errorf("invalid year '%s'", args[0]))
+		}
+		year = y
+	}
+	if len(args) > 1 {
+		m, err := strconv.Atoi(args[1])
+		if err != nil || m < 1 || m > 12 {
+			errs.add(fmt.Errorf("invalid month '%s'", args[1]))
+		}
+		month = time.Month(m)
+	}
+	s := sf.parse(&errs)
+	g := gf.parse(&errs)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+	if err := sf.load(); err != nil {
+		return err
+	}
+
+	g.selection = s
+	if month == 0 {
+		g.printYear(year)
+	} else {
+		g.printMonth(year, month)
+	}
+	return nil
+}
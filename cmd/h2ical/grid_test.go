@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+func TestGridMonth(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	testCases := []struct {
+		name         string
+		lang         language.Tag
+		firstWeekday time.Weekday
+		weekNumbers  bool
+		want         []string
+	}{
+		{"German", language.German, time.Monday, true, []string{
+			"        Mai 2025        ",
+			"KW Mo Di Mi Do Fr Sa So ",
+			"18           1* 2  3  4 ",
+			"19  5  6  7  8  9 10 11 ",
+			"20 12 13 14 15 16 17 18 ",
+			"21 19 20 21 22 23 24 25 ",
+			"22 26 27 28 29*30 31    ",
+			" 1 Tag der Arbeit       ",
+			"29 Christi Himmelfahrt  ",
+		}},
+		{"English", language.English, time.Sunday, false, []string{
+			"      May 2025       ",
+			"Su Mo Tu We Th Fr Sa ",
+			"             1* 2  3 ",
+			" 4  5  6  7  8  9 10 ",
+			"11 12 13 14 15 16 17 ",
+			"18 19 20 21 22 23 24 ",
+			"25 26 27 28 29*30 31 ",
+			" 1 Labour Day        ",
+			"29 Ascension Day     ",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := grid{
+				selection: selection{
+					lang:   tc.lang,
+					region: holidays.Bayern,
+					kinds:  map[holidays.Kind]bool{holidays.PublicHoliday: true, holidays.RegionalPublicHoliday: true},
+				},
+				firstWeekday: tc.firstWeekday,
+				weekNumbers:  tc.weekNumbers,
+			}
+			got := g.month(2025, time.May, g.holidaysOfYear(2025))
+			if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tc.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestGridKey(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+
+	g := grid{selection: selection{
+		lang:  language.German,
+		kinds: map[holidays.Kind]bool{holidays.PublicHoliday: true, holidays.Commemoration: true},
+	}}
+
+	color.NoColor = true
+	if got, want := g.key(), "* Feiertage"; got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+
+	color.NoColor = false
+	got := g.key()
+	for _, name := range []string{"Gesetzlicher Feiertag", "Gedenktag"} {
+		if !strings.Contains(got, name) {
+			t.Errorf("%s is missing in %q", name, got)
+		}
+	}
+	if strings.Contains(got, "Aktionstag") || strings.Contains(got, "public") {
+		t.Errorf("unexpected kinds in %q", got)
+	}
+}
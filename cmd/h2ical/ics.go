@@ -0,0 +1,48 @@
+package main
+
+import (
+	"io"
+
+	ics "github.com/arran4/golang-ical"
+)
+
+const icalDateFormat string = "20060102"
+
+// busyStatusProperty is the property Outlook uses for the free/busy status
+// of an event.
+const busyStatusProperty = ics.ComponentProperty("X-MICROSOFT-CDO-BUSYSTATUS")
+
+func (c calendar) writeICS(w io.Writer) error {
+	// The PRODID is set as is, as NewCalendarFor wraps it
+	cal := ics.NewCalendar()
+	cal.SetProductId(productID)
+	cal.SetCalscale("GREGORIAN")
+	cal.SetXWRCalName(c.name)
+
+	for _, e := range c.events {
+		// Properties are always set in the same order to get reproducible output
+		event := ics.NewEvent(e.uid)
+		event.SetDtStampTime(e.timestamp)
+		event.SetProperty(ics.ComponentPropertyDtStart, e.start.Format(icalDateFormat), ics.WithValue(string(ics.ValueDataTypeDate)))
+		event.SetProperty(ics.ComponentPropertyDtEnd, e.end.Format(icalDateFormat), ics.WithValue(string(ics.ValueDataTypeDate)))
+		event.SetSummary(e.summary)
+		event.SetDescription(e.description)
+		if e.busy {
+			event.SetTimeTransparency(ics.TransparencyOpaque)
+		} else {
+			event.SetTimeTransparency(ics.TransparencyTransparent)
+		}
+		event.SetProperty(busyStatusProperty, e.busyStatus())
+		event.SetProperty(ics.ComponentPropertyCategories, ics.ToText(e.category))
+		event.SetColor(e.color)
+		for _, a := range e.alarms {
+			alarm := event.AddAlarm()
+			alarm.SetAction(ics.ActionDisplay)
+			alarm.SetTrigger(a.trigger)
+			alarm.SetProperty(ics.ComponentPropertyDescription, ics.ToText(a.description))
+		}
+		cal.AddVEvent(event)
+	}
+
+	return cal.SerializeTo(w)
+}
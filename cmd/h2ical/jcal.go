@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// component is an iCalendar component in the structure shared by jCal
+// (RFC 7265) and xCal (RFC 6321).
+type component struct {
+	name       string
+	properties []property
+	components []component
+}
+
+// property is an iCalendar property. The value type is named as in jCal and
+// xCal, e.g. "date" or "text".
+type property struct {
+	name      string
+	valueType string
+	value     string
+}
+
+func (c calendar) component() component {
+	vcalendar := component{
+		name: "vcalendar",
+		properties: []property{
+			{"version", "text", "2.0"},
+			{"prodid", "text", productID},
+			{"calscale", "text", "GREGORIAN"},
+			{"x-wr-calname", "unknown", c.name},
+		},
+	}
+
+	for _, e := range c.events {
+		transp := "TRANSPARENT"
+		if e.busy {
+			transp = "OPAQUE"
+		}
+		var alarms []component
+		for _, a := range e.alarms {
+			alarms = append(alarms, component{
+				name: "valarm",
+				properties: []property{
+					{"action", "text", "DISPLAY"},
+					{"trigger", "duration", a.trigger},
+					{"description", "text", a.description},
+				},
+			})
+		}
+		vcalendar.components = append(vcalendar.components, component{
+			name: "vevent",
+			properties: []property{
+				{"uid", "text", e.uid},
+				{"dtstamp", "date-time", e.timestamp.Format("2006-01-02T15:04:05Z")},
+				{"dtstart", "date", e.start.Format("2006-01-02")},
+				{"dtend", "date", e.end.Format("2006-01-02")},
+				{"summary", "text", e.summary},
+				{"description", "text", e.description},
+				{"transp", "text", transp},
+				{"x-microsoft-cdo-busystatus", "unknown", e.busyStatus()},
+				{"categories", "text", e.category},
+				{"color", "text", e.color},
+			},
+			components: alarms,
+		})
+	}
+
+	return vcalendar
+}
+
+// MarshalJSON writes the component as jCal array of its name, properties
+// and subcomponents.
+func (c component) MarshalJSON() ([]byte, error) {
+	properties := make([][]interface{}, len(c.properties))
+	for i, p := range c.properties {
+		properties[i] = []interface{}{p.name, struct{}{}, p.valueType, p.value}
+	}
+	components := c.components
+	if components == nil {
+		components = []component{}
+	}
+	return json.Marshal([]interface{}{c.name, properties, components})
+}
+
+func (c calendar) writeJCal(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.component())
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCalendar returns a calendar with a busy event with an alarm and a
+// free event without one.
+func testCalendar() calendar {
+	stamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return calendar{
+		name: "Feiertage",
+		events: []event{
+			{
+				uid:         "UID-1",
+				timestamp:   stamp,
+				start:       date(2025, 5, 1),
+				end:         date(2025, 5, 2),
+				summary:     "Tag der Arbeit",
+				description: "Gesetzlicher Feiertag in Deutschland",
+				busy:        true,
+				category:    "Gesetzlicher Feiertag",
+				color:       "red",
+				alarms:      []alarm{{trigger: "-P1D", description: "Morgen: Tag der Arbeit"}},
+			},
+			{
+				uid:       "UID-2",
+				timestamp: stamp,
+				start:     date(2025, 5, 11),
+				end:       date(2025, 5, 12),
+				summary:   "Muttertag & <Vatertag>",
+				category:  "Aktionstag",
+				color:     "green",
+			},
+		},
+	}
+}
+
+func TestWriteICS(t *testing.T) {
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Kevin Morio//holidays2ics",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:Feiertage",
+		"BEGIN:VEVENT",
+		"UID:UID-1",
+		"DTSTAMP:20250102T030405Z",
+		"DTSTART;VALUE=DATE:20250501",
+		"DTEND;VALUE=DATE:20250502",
+		"SUMMARY:Tag der Arbeit",
+		"DESCRIPTION:Gesetzlicher Feiertag in Deutschland",
+		"TRANSP:OPAQUE",
+		"X-MICROSOFT-CDO-BUSYSTATUS:OOF",
+		"CATEGORIES:Gesetzlicher Feiertag",
+		"COLOR:red",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-P1D",
+		"DESCRIPTION:Morgen: Tag der Arbeit",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:UID-2",
+		"DTSTAMP:20250102T030405Z",
+		"DTSTART;VALUE=DATE:20250511",
+		"DTEND;VALUE=DATE:20250512",
+		"SUMMARY:Muttertag & <Vatertag>",
+		"DESCRIPTION:",
+		"TRANSP:TRANSPARENT",
+		"X-MICROSOFT-CDO-BUSYSTATUS:FREE",
+		"CATEGORIES:Aktionstag",
+		"COLOR:green",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	var b bytes.Buffer
+	if err := testCalendar().writeICS(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteJCal(t *testing.T) {
+	want := `["vcalendar",[["version",{},"text","2.0"],["prodid",{},"text","-//Kevin Morio//holidays2ics"],["calscale",{},"text","GREGORIAN"],["x-wr-calname",{},"unknown","Feiertage"]],[` +
+		`["vevent",[["uid",{},"text","UID-1"],["dtstamp",{},"date-time","2025-01-02T03:04:05Z"],["dtstart",{},"date","2025-05-01"],["dtend",{},"date","2025-05-02"],["summary",{},"text","Tag der Arbeit"],["description",{},"text","Gesetzlicher Feiertag in Deutschland"],["transp",{},"text","OPAQUE"],["x-microsoft-cdo-busystatus",{},"unknown","OOF"],["categories",{},"text","Gesetzlicher Feiertag"],["color",{},"text","red"]],[` +
+		`["valarm",[["action",{},"text","DISPLAY"],["trigger",{},"duration","-P1D"],["description",{},"text","Morgen: Tag der Arbeit"]],[]]]],` +
+		`["vevent",[["uid",{},"text","UID-2"],["dtstamp",{},"date-time","2025-01-02T03:04:05Z"],["dtstart",{},"date","2025-05-11"],["dtend",{},"date","2025-05-12"],["summary",{},"text","Muttertag & <Vatertag>"],["description",{},"text",""],["transp",{},"text","TRANSPARENT"],["x-microsoft-cdo-busystatus",{},"unknown","FREE"],["categories",{},"text","Aktionstag"],["color",{},"text","green"]],[]]]]`
+
+	var b bytes.Buffer
+	if err := testCalendar().writeJCal(&b); err != nil {
+		t.Fatal(err)
+	}
+	var got, wantJSON interface{}
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, wantJSON) {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestWriteXCal(t *testing.T) {
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<icalendar xmlns="urn:ietf:params:xml:ns:icalendar-2.0">
+  <vcalendar>
+    <properties>
+      <version>
+        <text>2.0</text>
+      </version>
+      <prodid>
+        <text>-//Kevin Morio//holidays2ics</text>
+      </prodid>
+      <calscale>
+        <text>GREGORIAN</text>
+      </calscale>
+      <x-wr-calname>
+        <unknown>Feiertage</unknown>
+      </x-wr-calname>
+    </properties>
+    <components>
+      <vevent>
+        <properties>
+          <uid>
+            <text>UID-1</text>
+          </uid>
+          <dtstamp>
+            <date-time>2025-01-02T03:04:05Z</date-time>
+          </dtstamp>
+          <dtstart>
+            <date>2025-05-01</date>
+          </dtstart>
+          <dtend>
+            <date>2025-05-02</date>
+          </dtend>
+          <summary>
+            <text>Tag der Arbeit</text>
+          </summary>
+          <description>
+            <text>Gesetzlicher Feiertag in Deutschland</text>
+          </description>
+          <transp>
+            <text>OPAQUE</text>
+          </transp>
+          <x-microsoft-cdo-busystatus>
+            <unknown>OOF</unknown>
+          </x-microsoft-cdo-busystatus>
+          <categories>
+            <text>Gesetzlicher Feiertag</text>
+          </categories>
+          <color>
+            <text>red</text>
+          </color>
+        </properties>
+        <components>
+          <valarm>
+            <properties>
+              <action>
+                <text>DISPLAY</text>
+              </action>
+              <trigger>
+                <duration>-P1D</duration>
+              </trigger>
+              <description>
+                <text>Morgen: Tag der Arbeit</text>
+              </description>
+            </properties>
+          </valarm>
+        </components>
+      </vevent>
+      <vevent>
+        <properties>
+          <uid>
+            <text>UID-2</text>
+          </uid>
+          <dtstamp>
+            <date-time>2025-01-02T03:04:05Z</date-time>
+          </dtstamp>
+          <dtstart>
+            <date>2025-05-11</date>
+          </dtstart>
+          <dtend>
+            <date>2025-05-12</date>
+          </dtend>
+          <summary>
+            <text>Muttertag &amp; &lt;Vatertag&gt;</text>
+          </summary>
+          <description>
+            <text></text>
+          </description>
+          <transp>
+            <text>TRANSPARENT</text>
+          </transp>
+          <x-microsoft-cdo-busystatus>
+            <unknown>FREE</unknown>
+          </x-microsoft-cdo-busystatus>
+          <categories>
+            <text>Aktionstag</text>
+          </categories>
+          <color>
+            <text>green</text>
+          </color>
+        </properties>
+      </vevent>
+    </components>
+  </vcalendar>
+</icalendar>
+`
+
+	var b bytes.Buffer
+	if err := testCalendar().writeXCal(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"github.com/kevinmorio/holidays2ical/holidays"
+	"golang.org/x/text/language"
+)
+
+// dateFormatFlag registers the flag overriding the date pattern of the
+// language.
+func dateFormatFlag(flags *flag.FlagSet) *string {
+	return flags.String("date-format", "", "the CLDR pattern of the dates, e.g. 'EEEE, d. MMMM y' (default depends on -lang)")
+}
+
+func printHolidays(hs []holidays.Holiday, lang language.Tag, dates dateFormatter) {
+	greyBold := color.New(color.FgBlack).Add(color.Bold).SprintFunc()
+	whiteBold := color.New(color.FgWhite).Add(color.Bold).SprintfFunc()
+
+	// Align the names, as the length of the dates depends on the language
+	formatted := make([]string, len(hs))
+	width := 0
+	for i, holiday := range hs {
+		formatted[i] = dates.format(holiday.Date)
+		if n := utf8.RuneCountInString(formatted[i]); n > width {
+			width = n
+		}
+	}
+
+	for i, holiday := range hs {
+		fmt.Printf("%s    %s\n", greyBold(pad(formatted[i], width)), whiteBold(holiday.Name.Translate(lang)))
+	}
+}
+
+func list(flags *flag.FlagSet, args []string) error {
+	var sf selectionFlags
+	var rf rangeFlags
+	var gf gridFlags
+	sf.register(flags)
+	rf.register(flags)
+	format := flags.String("format", "lines", "the output format (lines|grid)")
+	dateFormat := dateFormatFlag(flags)
+	gf.register(flags)
+	flags.Parse(args)
+
+	var errs errorList
+	if flags.NArg() > 0 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " ")))
+	}
+	s := sf.parse(&errs)
+	rf.visit(flags)
+	start, end := rf.parse(time.Now(), &errs)
+	g := gf.parse(&errs)
+	if *format != "lines" && *format != "grid" {
+		errs.add(fmt.Errorf("invalid format '%s'", *format))
+	}
+	dates, err := newDateFormatter(s.lang, *dateFormat)
+	errs.add(err)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+	if err := sf.load(); err != nil {
+		return err
+	}
+
+	if *format == "grid" {
+		g.selection = s
+		g.printRange(start, end)
+		return nil
+	}
+
+	printHolidays(s.holidaysBetween(start, end), s.lang, dates)
+	return nil
+}
+
+func next(flags *flag.FlagSet, args []string) error {
+	var sf selectionFlags
+	sf.register(flags)
+	count := flags.Int("n", 5, "the number of holidays to list")
+	dateFormat := dateFormatFlag(flags)
+	flags.Parse(args)
+
+	var errs errorList
+	if flags.NArg() > 0 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " ")))
+	}
+	s := sf.parse(&errs)
+	if *count < 1 {
+		errs.add(fmt.Errorf("invalid number of holidays %d", *count))
+	}
+	dates, err := newDateFormatter(s.lang, *dateFormat)
+	errs.add(err)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+	if err := sf.load(); err != nil {
+		return err
+	}
+
+	// Include the holidays of today and look ahead year by year
+	var upcoming []holidays.Holiday
+	from := time.Now()
+	for i := 0; i < 10 && len(upcoming) < *count; i++ {
+		to := from.AddDate(1, 0, 0)
+		upcoming = append(upcoming, holidays.HolidaysBetween(from, to.AddDate(0, 0, -1), s.filters()...)...)
+		from = to
+	}
+	if len(upcoming) > *count {
+		upcoming = upcoming[:*count]
+	}
+
+	printHolidays(upcoming, s.lang, dates)
+	return nil
+}
+
+func on(flags *flag.FlagSet, args []string) error {
+	var sf selectionFlags
+	sf.register(flags)
+	dateFormat := dateFormatFlag(flags)
+	args = parseInterspersed(flags, args)
+
+	var errs errorList
+	var date time.Time
+	if len(args) != 1 {
+		errs.add(fmt.Errorf("expected a single date"))
+	} else if d, err := time.Parse("2006-01-02", args[0]); err != nil {
+		errs.add(fmt.Errorf("invalid date '%s'", args[0]))
+	} else {
+		date = d
+	}
+	s := sf.parse(&errs)
+	dates, err := newDateFormatter(s.lang, *dateFormat)
+	errs.add(err)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+	if err := sf.load(); err != nil {
+		return err
+	}
+
+	printHolidays(holidays.HolidaysOn(date, s.filters()...), s.lang, dates)
+	return nil
+}
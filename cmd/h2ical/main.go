@@ -1,106 +1,114 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"strings"
-	"time"
-
-	ics "github.com/arran4/golang-ical"
-	"github.com/fatih/color"
-	"github.com/google/uuid"
-	"github.com/kevinmorio/holidays2ical/holidays"
-	"golang.org/x/text/language"
 )
 
-var calendarName = holidays.TranslatedString{
-	language.German:  "Feiertage",
-	language.English: "Holidays",
+// command is a subcommand of h2ical.
+type command struct {
+	name string
+	// args is the synopsis of the positional arguments.
+	args    string
+	summary string
+	aliases []string
+	run     func(flags *flag.FlagSet, args []string) error
 }
 
-const (
-	icalDateFormat string = "20060102"
-	ICSFormat      string = "ics"
-	StdoutFormat   string = "stdout"
-)
-
-func holidayToEvent(h *holidays.Holiday, lang language.Tag) (*ics.VEvent, error) {
-	event := ics.NewEvent(strings.ToUpper(uuid.NewString()))
-
-	event.SetProperty(ics.ComponentPropertyDtStart, h.Date.UTC().Format(icalDateFormat), ics.WithValue(string(ics.ValueDataTypeDate)))
-	event.SetProperty(ics.ComponentPropertyDtEnd, h.Date.AddDate(0, 0, 1).UTC().Format(icalDateFormat), ics.WithValue(string(ics.ValueDataTypeDate)))
-
-	event.SetTimeTransparency(ics.TransparencyTransparent)
+var commands = []command{
+	{name: "generate", summary: "Generate a calendar file of the holidays.", run: generate},
+	{name: "list", summary: "List the holidays in the terminal.", run: list},
+	{name: "next", summary: "List the upcoming holidays.", run: next},
+	{name: "cal", args: "[YEAR [MONTH]]", summary: "Show a year or month grid like cal(1) with the holidays highlighted.", run: cal},
+	{name: "on", args: "DATE", summary: "List the holidays on a date given as YYYY-MM-DD.", run: on},
+	{name: "check", summary: "Check the holiday definitions for errors and missing translations.", aliases: []string{"lint"}, run: check},
+	{name: "serve", summary: "Serve calendars and the JSON API over HTTP.", run: serve},
+}
 
-	// Consider event name as required
-	hName, ok := h.Name[lang]
-	if !ok {
-		return nil, fmt.Errorf("Name not available for language '%s`", lang)
+func lookupCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+		for _, alias := range c.aliases {
+			if alias == name {
+				return c, true
+			}
+		}
 	}
+	return command{}, false
+}
 
-	// Description is optional
-	hDescription := h.Description[lang]
+func (c command) flagSet() *flag.FlagSet {
+	flags := flag.NewFlagSet(c.name, flag.ExitOnError)
+	flags.Usage = func() {
+		synopsis := "h2ical " + c.name + " [flags]"
+		if c.args != "" {
+			synopsis += " " + c.args
+		}
+		fmt.Fprintf(flags.Output(), "Usage: %s\n\n%s\n\nFlags:\n", synopsis, c.summary)
+		flags.PrintDefaults()
+	}
+	return flags
+}
 
-	event.SetSummary(hName)
-	event.SetDtStampTime(time.Now())
-	event.SetDescription(hDescription)
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: h2ical <command> [flags]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'h2ical help <command>' for the flags of a command.\n")
+}
 
-	return event, nil
+// parseInterspersed parses the flags in args and returns the positional
+// arguments, which may appear before, between or after the flags.
+func parseInterspersed(flags *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		flags.Parse(args)
+		args = flags.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
 }
 
 func main() {
-	fromYear := flag.Int("from", time.Now().Year(), "year to start from")
-	tillYear := flag.Int("till", time.Now().Year(), "year to end")
-	lang := flag.String("lang", "de", "the language used for the holidays")
-	format := flag.String("format", "stdout", "the output format for the holidays (ics|stdout)")
-	outfilePath := flag.String("outfile", "Holidays.ics", "the outfile of the calendar")
-
-	flag.Parse()
-
-	langTag, err := language.Parse(*lang)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Invalid language tag '%s'\n", *lang)
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(exitUsage)
 	}
 
-	switch *format {
-	case ICSFormat:
-		cal := ics.NewCalendarFor("-//Kevin Morio//holidays2ics")
-		cal.SetCalscale("GREGORIAN")
-		cal.SetXWRCalName(calendarName[langTag])
-
-		for year := *fromYear; year <= *tillYear; year++ {
-			for _, holiday := range holidays.HolidaysForYear(year) {
-				event, err := holidayToEvent(&holiday, langTag)
-				if err != nil {
-					fmt.Printf("couldn't create event: %s", err.Error())
-					continue
-				}
-				cal.AddVEvent(event)
-			}
+	name, args := os.Args[1], os.Args[2:]
+	switch name {
+	case "help", "-h", "-help", "--help":
+		if len(args) == 0 {
+			usage()
+			return
 		}
+		name, args = args[0], []string{"-h"}
+	}
 
-		outfile, err := os.Create(*outfilePath)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%s\n", err)
-		}
-		defer outfile.Close()
+	c, ok := lookupCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "h2ical: unknown command '%s'\n\n", name)
+		usage()
+		os.Exit(exitUsage)
+	}
 
-		if err := cal.SerializeTo(outfile); err != nil {
-			fmt.Fprintf(os.Stderr, "%s\n", err)
+	err := c.run(c.flagSet(), args)
+	if err != nil {
+		for _, line := range strings.Split(err.Error(), "\n") {
+			fmt.Fprintf(os.Stderr, "h2ical %s: %s\n", c.name, line)
 		}
-		fmt.Printf("Saved calendar to %s\n", *outfilePath)
-	case StdoutFormat:
-		greyBold := color.New(color.FgBlack).Add(color.Bold).SprintFunc()
-		whiteBold := color.New(color.FgWhite).Add(color.Bold).SprintfFunc()
-
-		for year := *fromYear; year <= *tillYear; year++ {
-			for _, holiday := range holidays.HolidaysForYear(year) {
-				fmt.Printf("%s    %s\n", greyBold(holiday.Date.Format("Mon Jan _2 2006")), whiteBold(holiday.Name[langTag]))
-			}
+		if errors.As(err, &usageError{}) {
+			fmt.Fprintf(os.Stderr, "Run 'h2ical help %s' for usage.\n", c.name)
 		}
-	default:
-		fmt.Printf("invalid format: %s\n", *format)
-		os.Exit(1)
 	}
+	os.Exit(exitCode(err))
 }
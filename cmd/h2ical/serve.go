@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kevinmorio/holidays2ical/api"
+	"github.com/kevinmorio/holidays2ical/holidays"
+)
+
+const (
+	// maxFeedYears limits the years of a feed to keep requests cheap.
+	maxFeedYears = 50
+	// maxCachedFeeds limits the memory used by the cache, which is cleared
+	// when it is full.
+	maxCachedFeeds = 1024
+)
+
+// feedServer serves calendars for the parameters of the query, using the
+// flags of serve as defaults.
+type feedServer struct {
+	defaults selectionFlags
+	dates    rangeFlags
+	busy     busyFlags
+	events   eventOptions
+
+	mu    sync.Mutex
+	cache map[string]feed
+}
+
+// feed is a generated calendar.
+type feed struct {
+	body     []byte
+	etag     string
+	modified time.Time
+}
+
+// rangeParameters are the query parameters selecting the dates of a feed.
+// If any of them is given, the range of the flags is ignored.
+var rangeParameters = []string{"from", "till", "start", "end", "window"}
+
+func (srv *feedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var errs errorList
+	sf, rf, bf := srv.defaults, srv.dates, srv.busy
+	query := r.URL.Query()
+	if query.Has("region") {
+		sf.region = query.Get("region")
+	}
+	if query.Has("lang") {
+		sf.lang = query.Get("lang")
+	}
+	if query.Has("kinds") {
+		sf.kinds = query.Get("kinds")
+	}
+	if query.Has("busy") {
+		bf.busy = query.Get("busy")
+	}
+	if query.Has("free") {
+		bf.free = query.Get("free")
+	}
+	now := time.Now()
+	for _, name := range rangeParameters {
+		if query.Has(name) {
+			rf = rangeFlags{explicit: map[string]bool{}}
+			break
+		}
+	}
+	for _, name := range rangeParameters {
+		if !query.Has(name) {
+			continue
+		}
+		rf.explicit[name] = true
+		value := query.Get(name)
+		switch name {
+		case "from", "till":
+			year, err := strconv.Atoi(value)
+			if err != nil {
+				errs.add(fmt.Errorf("invalid %s '%s'", name, value))
+			} else if name == "from" {
+				rf.from = year
+			} else {
+				rf.till = year
+			}
+		case "start":
+			rf.start = value
+		case "end":
+			rf.end = value
+		case "window":
+			rf.window = value
+		}
+	}
+	s := sf.parse(&errs)
+	start, end := rf.parse(now, &errs)
+	events := srv.events
+	var err error
+	events.busy, err = bf.parse()
+	errs.add(err)
+	if end.After(start.AddDate(maxFeedYears, 0, 0)) {
+		errs.add(fmt.Errorf("more than %d years requested", maxFeedYears))
+	}
+	if len(errs) > 0 {
+		http.Error(w, errs.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f, err := srv.feed(s, start, end, events)
+	if err != nil {
+		log.Printf("%s: %s", r.URL, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="Holidays.ics"`)
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.Header().Set("ETag", f.etag)
+	http.ServeContent(w, r, "", f.modified, bytes.NewReader(f.body))
+}
+
+// feed returns the calendar of the selection from the cache or generates it.
+func (srv *feedServer) feed(s selection, start, end time.Time, events eventOptions) (feed, error) {
+	var kinds []string
+	for _, kind := range holidays.AllKinds {
+		if s.kinds[kind] {
+			kinds = append(kinds, kind.String())
+		}
+	}
+	var overrides []string
+	for id, busy := range events.busy {
+		overrides = append(overrides, fmt.Sprintf("%s=%t", id, busy))
+	}
+	sort.Strings(overrides)
+	key := fmt.Sprintf("%s|%s|%s|%s|%s|%s", s.lang, s.region, strings.Join(kinds, ","), start.Format("2006-01-02"), end.Format("2006-01-02"), strings.Join(overrides, ","))
+
+	srv.mu.Lock()
+	f, ok := srv.cache[key]
+	srv.mu.Unlock()
+	if ok {
+		return f, nil
+	}
+
+	// The feed is generated without holding the lock, so that other requests
+	// aren't blocked. Concurrent requests for the same feed may generate it
+	// more than once.
+	cal, err := newCalendar(s.holidaysBetween(start, end), s, events)
+	if err != nil {
+		return feed{}, err
+	}
+	var body bytes.Buffer
+	if err := cal.writeICS(&body); err != nil {
+		return feed{}, err
+	}
+
+	// Last-Modified has a resolution of seconds
+	f = feed{
+		body:     body.Bytes(),
+		etag:     fmt.Sprintf(`"%x"`, sha256.Sum256(body.Bytes())),
+		modified: time.Now().Truncate(time.Second),
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	// Keep a feed stored in the meantime, so that its Last-Modified doesn't
+	// change
+	if cached, ok := srv.cache[key]; ok {
+		return cached, nil
+	}
+	if srv.cache == nil || len(srv.cache) >= maxCachedFeeds {
+		srv.cache = map[string]feed{}
+	}
+	srv.cache[key] = f
+	return f, nil
+}
+
+func serve(flags *flag.FlagSet, args []string) error {
+	srv := &feedServer{}
+	srv.defaults.register(flags)
+	srv.dates.register(flags)
+	srv.busy.register(flags)
+	addr := flags.String("addr", "localhost:8080", "the address to listen on")
+	srv.events.alarms.register(flags)
+	timestampValue := flags.String("timestamp", "", "the DTSTAMP of the events as Unix time or RFC 3339 date (default $SOURCE_DATE_EPOCH or now)")
+	flags.Parse(args)
+
+	var errs errorList
+	if flags.NArg() > 0 {
+		errs.add(fmt.Errorf("unexpected arguments %s", strings.Join(flags.Args(), " ")))
+	}
+	srv.defaults.parse(&errs)
+	srv.dates.visit(flags)
+	srv.dates.parse(time.Now(), &errs)
+	timestamp, err := parseTimestamp(*timestampValue)
+	errs.add(err)
+	if err := errs.asUsageError(); err != nil {
+		return err
+	}
+	if err := srv.defaults.load(); err != nil {
+		return err
+	}
+	if _, err := srv.busy.parse(); err != nil {
+		return usageError{err}
+	}
+
+	srv.events.timestamp = timestamp
+
+	mux := http.NewServeMux()
+	mux.Handle("/calendar.ics", srv)
+	mux.Handle("/v1/", api.NewServer(holidays.Builtin()))
+
+	log.Printf("Serving calendars at http://%s/calendar.ics and the API at http://%s/v1/", *addr, *addr)
+	return http.ListenAndServe(*addr, mux)
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestFeedServer returns a feed server configured with the flags of serve
+// given as args.
+func newTestFeedServer(t *testing.T, args ...string) (*feedServer, *httptest.Server) {
+	srv := &feedServer{}
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	srv.defaults.register(flags)
+	srv.dates.register(flags)
+	srv.busy.register(flags)
+	if err := flags.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	srv.dates.visit(flags)
+	srv.events.timestamp = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return srv, ts
+}
+
+func get(t *testing.T, url string, header http.Header) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
+
+// eventOn returns the lines of the first event of the calendar starting on
+// the date given as YYYYMMDD.
+func eventOn(body, date string) string {
+	for _, e := range strings.Split(body, "BEGIN:VEVENT")[1:] {
+		if strings.Contains(e, "DTSTART;VALUE=DATE:"+date+"\r\n") {
+			return e
+		}
+	}
+	return ""
+}
+
+func TestServeFeed(t *testing.T) {
+	_, ts := newTestFeedServer(t, "-from", "2020", "-till", "2020")
+	thisYear := strconv.Itoa(time.Now().Year())
+
+	testCases := []struct {
+		query   string
+		want    []string
+		notWant []string
+	}{
+		{"", []string{"X-WR-CALNAME:Feiertage", "DTSTART;VALUE=DATE:20200101"}, []string{"DTSTART;VALUE=DATE:20250101"}},
+		{"lang=en&from=2025", []string{"X-WR-CALNAME:Holidays", "SUMMARY:New Year", "DTSTART;VALUE=DATE:20250101"}, []string{"DTSTART;VALUE=DATE:20200101"}},
+		{"from=2025&till=2026", []string{"DTSTART;VALUE=DATE:20250101", "DTSTART;VALUE=DATE:20261231"}, []string{"DTSTART;VALUE=DATE:20200101"}},
+		{"till=" + thisYear, []string{"DTSTART;VALUE=DATE:" + thisYear + "0101"}, []string{"DTSTART;VALUE=DATE:20200101"}},
+		{"start=2025-12-01&end=2025-12-31", []string{"DTSTART;VALUE=DATE:20251225"}, []string{"DTSTART;VALUE=DATE:20251130", "DTSTART;VALUE=DATE:20260101"}},
+		{"window=0:%2B1y", []string{"BEGIN:VEVENT"}, []string{"DTSTART;VALUE=DATE:20200101"}},
+		{"from=2025&region=DE-BY&kinds=public,regional", []string{"DTSTART;VALUE=DATE:20250619"}, []string{"DTSTART;VALUE=DATE:20251031", "DTSTART;VALUE=DATE:20251224"}},
+		{"from=2025&region=DE-HH&kinds=public,regional", []string{"DTSTART;VALUE=DATE:20251031"}, []string{"DTSTART;VALUE=DATE:20250619"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			resp, body := get(t, ts.URL+"/calendar.ics?"+tc.query, nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status %d: %s", resp.StatusCode, body)
+			}
+			if got := resp.Header.Get("Content-Type"); got != "text/calendar; charset=utf-8" {
+				t.Errorf("got Content-Type %s", got)
+			}
+			for _, s := range tc.want {
+				if !strings.Contains(body, s+"\r\n") {
+					t.Errorf("%s is missing", s)
+				}
+			}
+			for _, s := range tc.notWant {
+				if strings.Contains(body, s+"\r\n") {
+					t.Errorf("unexpected %s", s)
+				}
+			}
+		})
+	}
+}
+
+func TestServeBusy(t *testing.T) {
+	_, ts := newTestFeedServer(t, "-from", "2025", "-busy", "christmas-eve")
+
+	testCases := []struct {
+		query string
+		date  string
+		want  string
+	}{
+		{"", "20251224", "TRANSP:OPAQUE"},
+		{"", "20251225", "TRANSP:OPAQUE"},
+		{"", "20251231", "TRANSP:TRANSPARENT"},
+		{"busy=silvester", "20251224", "TRANSP:TRANSPARENT"},
+		{"busy=silvester", "20251231", "TRANSP:OPAQUE"},
+		{"free=first-christmas-day", "20251225", "TRANSP:TRANSPARENT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query+" "+tc.date, func(t *testing.T) {
+			resp, body := get(t, ts.URL+"/calendar.ics?"+tc.query, nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("got status %d: %s", resp.StatusCode, body)
+			}
+			if e := eventOn(body, tc.date); !strings.Contains(e, tc.want+"\r\n") {
+				t.Errorf("%s is missing in\n%s", tc.want, e)
+			}
+		})
+	}
+}
+
+func TestServeErrors(t *testing.T) {
+	_, ts := newTestFeedServer(t, "-from", "2025")
+
+	testCases := []string{
+		"region=DE-XX",
+		"lang=!",
+		"kinds=bank-holiday",
+		"from=next",
+		"from=0",
+		"from=9223372036854775807&till=9223372036854775807",
+		"from=2026&till=2025",
+		"from=2000&till=2060",
+		"start=2025-01-01&from=2025",
+		"start=2025-02-30",
+		"window=-3m",
+		"window=-3m:%2B1m&till=2026",
+		"busy=unknown",
+		"busy=silvester&free=silvester",
+	}
+
+	for _, query := range testCases {
+		t.Run(query, func(t *testing.T) {
+			resp, body := get(t, ts.URL+"/calendar.ics?"+query, nil)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("got status %d; want %d: %s", resp.StatusCode, http.StatusBadRequest, body)
+			}
+		})
+	}
+
+	resp, err := http.Post(ts.URL+"/calendar.ics", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got status %d; want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
+
+func TestServeCache(t *testing.T) {
+	srv, ts := newTestFeedServer(t, "-from", "2025")
+
+	resp, body := get(t, ts.URL+"/calendar.ics?busy=silvester,christmas-eve", nil)
+	etag, modified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if etag == "" || modified == "" {
+		t.Fatalf("got ETag %q and Last-Modified %q", etag, modified)
+	}
+
+	// The order of the overrides doesn't matter
+	resp, again := get(t, ts.URL+"/calendar.ics?busy=christmas-eve,silvester", nil)
+	if resp.Header.Get("ETag") != etag || again != body {
+		t.Error("the same feed differs")
+	}
+	if len(srv.cache) != 1 {
+		t.Errorf("got %d cached feeds; want 1", len(srv.cache))
+	}
+
+	resp, _ = get(t, ts.URL+"/calendar.ics?busy=silvester,christmas-eve", http.Header{"If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-None-Match: got status %d; want %d", resp.StatusCode, http.StatusNotModified)
+	}
+	resp, _ = get(t, ts.URL+"/calendar.ics?busy=silvester,christmas-eve", http.Header{"If-Modified-Since": {modified}})
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("If-Modified-Since: got status %d; want %d", resp.StatusCode, http.StatusNotModified)
+	}
+
+	resp, _ = get(t, ts.URL+"/calendar.ics?lang=en", http.Header{"If-None-Match": {etag}})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("other feed: got status %d; want %d", resp.StatusCode, http.StatusOK)
+	}
+	if resp.Header.Get("ETag") == etag {
+		t.Error("other feed has the same ETag")
+	}
+	if len(srv.cache) != 2 {
+		t.Errorf("got %d cached feeds; want 2", len(srv.cache))
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+const xcalNamespace = "urn:ietf:params:xml:ns:icalendar-2.0"
+
+// MarshalXML writes the component as xCal element with the properties and
+// subcomponents as children.
+func (c component) MarshalXML(enc *xml.Encoder, _ xml.StartElement) error {
+	element := func(name string, content func() error) error {
+		start := xml.StartElement{Name: xml.Name{Local: name}}
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		if err := content(); err != nil {
+			return err
+		}
+		return enc.EncodeToken(start.End())
+	}
+
+	return element(c.name, func() error {
+		err := element("properties", func() error {
+			for _, p := range c.properties {
+				err := element(p.name, func() error {
+					return enc.EncodeElement(p.value, xml.StartElement{Name: xml.Name{Local: p.valueType}})
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil || len(c.components) == 0 {
+			return err
+		}
+
+		return element("components", func() error {
+			for _, sub := range c.components {
+				if err := enc.Encode(sub); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (c calendar) writeXCal(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	document := struct {
+		XMLName   xml.Name  `xml:"icalendar"`
+		Namespace string    `xml:"xmlns,attr"`
+		Calendar  component `xml:"vcalendar"`
+	}{Namespace: xcalNamespace, Calendar: c.component()}
+	if err := enc.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
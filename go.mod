@@ -3,11 +3,16 @@ module github.com/kevinmorio/holidays2ical
 go 1.19
 
 require (
-	github.com/arran4/golang-ical v0.0.0-20221122102835-109346913e54 // indirect
-	github.com/fatih/color v1.15.0 // indirect
-	github.com/google/uuid v1.3.0 // indirect
+	github.com/arran4/golang-ical v0.0.0-20221122102835-109346913e54
+	github.com/fatih/color v1.15.0
+	github.com/google/uuid v1.3.0
+	golang.org/x/text v0.5.0
+	sigs.k8s.io/yaml v1.3.0
+)
+
+require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.17 // indirect
 	golang.org/x/sys v0.6.0 // indirect
-	golang.org/x/text v0.5.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
@@ -0,0 +1,493 @@
+package holidays
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	statutory = TranslatedString{
+		language.German:  "Gesetzlicher Feiertag",
+		language.English: "Public holiday",
+	}
+	regionalStatutory = TranslatedString{
+		language.German:  "Feiertag in {regions}",
+		language.English: "Public holiday in {regions}",
+	}
+	commemoration = TranslatedString{
+		language.German:  "Gedenktag",
+		language.English: "Day of remembrance",
+	}
+	regionalCommemoration = TranslatedString{
+		language.German:  "Gedenktag in {regions}",
+		language.English: "Day of remembrance in {regions}",
+	}
+)
+
+// always returns a single period covering all years. Without regions the
+// holiday is nationwide.
+func always(kind Kind, regions ...Region) []Period {
+	return []Period{{Kind: kind, Nationwide: len(regions) == 0, Regions: regions}}
+}
+
+var builtinDefinitions = []Definition{
+	{
+		ID: "new-year",
+		Name: TranslatedString{
+			language.German:  "Neujahrstag",
+			language.English: "New Year",
+		},
+		Rule:        Fixed(time.January, 1),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "epiphany",
+		Name: TranslatedString{
+			language.German:  "Heilige Drei Könige",
+			language.English: "Epiphany",
+		},
+		Rule:        Fixed(time.January, 6),
+		Description: regionalStatutory,
+		Periods:     always(RegionalPublicHoliday, BadenWürttemberg, Bayern, SachsenAnhalt),
+	},
+	{
+		ID: "valentines-day",
+		Name: TranslatedString{
+			language.German:  "Valentinstag",
+			language.English: "Valentine's Day",
+		},
+		Rule:        Fixed(time.February, 14),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "rosenmontag",
+		Name: TranslatedString{
+			language.German:  "Rosenmontag",
+			language.English: "Rose Monday",
+		},
+		Rule:        EasterOffset(-48),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "shrove-tuesday",
+		Name: TranslatedString{
+			language.German:  "Faschingsdienstag",
+			language.English: "Shrove Tuesday",
+		},
+		Rule:        EasterOffset(-47),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "ash-wednesday",
+		Name: TranslatedString{
+			language.German:  "Aschermittwoch",
+			language.English: "Ash Wednesday",
+		},
+		Rule:        EasterOffset(-46),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "womens-day",
+		Name: TranslatedString{
+			language.German:  "Internationaler Frauentag",
+			language.English: "International Women's Day",
+		},
+		Rule:        Fixed(time.March, 8),
+		Description: regionalStatutory,
+		Periods: []Period{
+			{From: 2023, Kind: RegionalPublicHoliday, Regions: []Region{Berlin, MecklenburgVorpommern}},
+			{From: 2019, Kind: RegionalPublicHoliday, Regions: []Region{Berlin}},
+			{Kind: Commemoration, Nationwide: true, Description: commemoration},
+		},
+	},
+	{
+		ID: "start-of-dst",
+		Name: TranslatedString{
+			language.German:  "Beginn der Sommerzeit",
+			language.English: "Start of Daylight Saving Time",
+		},
+		Rule:    LastWeekday(time.Sunday, time.March),
+		Periods: always(ClockChange),
+	},
+	{
+		ID: "palm-sunday",
+		Name: TranslatedString{
+			language.German:  "Palmsonntag",
+			language.English: "Palm Sunday",
+		},
+		Rule:        EasterOffset(-7),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "maundy-thursday",
+		Name: TranslatedString{
+			language.German:  "Gründonnerstag",
+			language.English: "Maundy Thursday",
+		},
+		Rule:        EasterOffset(-3),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "good-friday",
+		Name: TranslatedString{
+			language.German:  "Karfreitag",
+			language.English: "Good Friday",
+		},
+		Rule:        EasterOffset(-2),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "holy-saturday",
+		Name: TranslatedString{
+			language.German:  "Karsamstag",
+			language.English: "Holy Saturday",
+		},
+		Rule:        EasterOffset(-1),
+		Description: regionalCommemoration,
+		Periods:     always(Observance, Bayern, Hessen, Niedersachsen, Saarland, RheinlandPfalz),
+	},
+	{
+		ID: "easter",
+		Name: TranslatedString{
+			language.German:  "Ostern",
+			language.English: "Easter",
+		},
+		Rule:        EasterOffset(0),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "easter-monday",
+		Name: TranslatedString{
+			language.German:  "Ostermontag",
+			language.English: "Easter Monday",
+		},
+		Rule:        EasterOffset(1),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "workers-day",
+		Name: TranslatedString{
+			language.German:  "Tag der Arbeit",
+			language.English: "Labour Day",
+		},
+		Rule:        Fixed(time.May, 1),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "victory-in-europe-day",
+		Name: TranslatedString{
+			language.German:  "Jahrestag der Befreiung vom Nationalsozialismus",
+			language.English: "Victory in Europe Day",
+		},
+		Rule:        Fixed(time.May, 8),
+		Description: regionalCommemoration,
+		Periods: []Period{
+			// 75th and 80th anniversary
+			{From: 2020, Till: 2020, Kind: RegionalPublicHoliday, Regions: []Region{Berlin}, Description: TranslatedString{
+				language.German:  "Einmaliger Feiertag in {regions}",
+				language.English: "One-off public holiday in {regions}",
+			}},
+			{From: 2025, Till: 2025, Kind: RegionalPublicHoliday, Regions: []Region{Berlin}, Description: TranslatedString{
+				language.German:  "Einmaliger Feiertag in {regions}",
+				language.English: "One-off public holiday in {regions}",
+			}},
+			{Kind: Commemoration, Regions: []Region{Berlin, Brandenburg, Bremen, MecklenburgVorpommern, Thüringen}},
+		},
+	},
+	{
+		ID: "mothers-day",
+		Name: TranslatedString{
+			language.German:  "Muttertag",
+			language.English: "Mother's Day",
+		},
+		Rule:        NthWeekday(2, time.Sunday, time.May),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "ascension-day",
+		Name: TranslatedString{
+			language.German:  "Christi Himmelfahrt",
+			language.English: "Ascension Day",
+		},
+		Rule:        EasterOffset(39),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "fathers-day",
+		Name: TranslatedString{
+			language.German:  "Vatertag",
+			language.English: "Father's Day",
+		},
+		Rule:    Relative("ascension-day", 0),
+		Periods: always(Observance),
+	},
+	{
+		ID: "pentecost",
+		Name: TranslatedString{
+			language.German:  "Pfingsten",
+			language.English: "Pentecost",
+		},
+		Rule:        EasterOffset(49),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "pentecost-monday",
+		Name: TranslatedString{
+			language.German:  "Pfingstmontag",
+			language.English: "Whit Monday",
+		},
+		Rule:        EasterOffset(50),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "corpus-christi",
+		Name: TranslatedString{
+			language.German:  "Fronleichnam",
+			language.English: "Corpus Christi",
+		},
+		Rule:        EasterOffset(60),
+		Description: regionalStatutory,
+		Periods:     always(RegionalPublicHoliday, BadenWürttemberg, Bayern, Hessen, NordrheinWestfalen, RheinlandPfalz, Saarland, Sachsen, Thüringen),
+	},
+	{
+		ID: "augsburger-hohes-friedensfest",
+		Name: TranslatedString{
+			language.German:  "Augsburger Hohes Friedensfest",
+			language.English: "Augsburg Peace Festival",
+		},
+		Rule: Fixed(time.August, 8),
+		// Only a public holiday in the city of Augsburg
+		Description: TranslatedString{
+			language.German:  "Feiertag in der Stadt Augsburg",
+			language.English: "Public holiday in the city of Augsburg",
+		},
+		Periods: always(Commemoration, Bayern),
+	},
+	{
+		ID: "assumption-of-mary",
+		Name: TranslatedString{
+			language.German:  "Mariä Himmelfahrt",
+			language.English: "Assumption Day",
+		},
+		Rule:        Fixed(time.August, 15),
+		Description: regionalCommemoration,
+		Periods: []Period{
+			{Kind: RegionalPublicHoliday, Regions: []Region{Bayern, Saarland}, Description: regionalStatutory},
+			{Kind: Commemoration, Regions: []Region{Sachsen, Thüringen}},
+		},
+	},
+	{
+		ID: "childrens-day",
+		Name: TranslatedString{
+			language.German:  "Weltkindertag",
+			language.English: "World Children's Day",
+		},
+		Rule:        Fixed(time.September, 20),
+		Description: regionalStatutory,
+		Periods: []Period{
+			{From: 2019, Kind: RegionalPublicHoliday, Regions: []Region{Thüringen}},
+			{Kind: Observance, Nationwide: true, Description: commemoration},
+		},
+	},
+	{
+		ID: "german-unity-day",
+		Name: TranslatedString{
+			language.German:  "Tag der Deutschen Einheit",
+			language.English: "German Unity Day",
+		},
+		Rule:        Fixed(time.October, 3),
+		Description: statutory,
+		Periods: []Period{
+			{From: 1990, Kind: PublicHoliday, Nationwide: true},
+		},
+	},
+	{
+		ID: "end-of-dst",
+		Name: TranslatedString{
+			language.German:  "Ende der Sommerzeit",
+			language.English: "End of Daylight Saving Time",
+		},
+		Rule:    LastWeekday(time.Sunday, time.October),
+		Periods: always(ClockChange),
+	},
+	{
+		ID: "reformation-day",
+		Name: TranslatedString{
+			language.German:  "Reformationstag",
+			language.English: "Reformation Day",
+		},
+		Rule:        Fixed(time.October, 31),
+		Description: regionalStatutory,
+		Periods: []Period{
+			{From: 2018, Kind: RegionalPublicHoliday, Regions: []Region{Brandenburg, Bremen, Hamburg, MecklenburgVorpommern, Niedersachsen, Sachsen, SachsenAnhalt, SchleswigHolstein, Thüringen}},
+			// 500th anniversary of the Reformation
+			{From: 2017, Till: 2017, Kind: PublicHoliday, Nationwide: true, Description: TranslatedString{
+				language.German:  "Einmaliger gesetzlicher Feiertag",
+				language.English: "One-off public holiday",
+			}},
+			{From: 1990, Kind: RegionalPublicHoliday, Regions: []Region{Brandenburg, MecklenburgVorpommern, Sachsen, SachsenAnhalt, Thüringen}},
+		},
+	},
+	{
+		ID: "halloween",
+		Name: TranslatedString{
+			language.German:  "Halloween",
+			language.English: "Halloween",
+		},
+		Rule:    Fixed(time.October, 31),
+		Periods: always(Observance),
+	},
+	{
+		ID: "all-saints-day",
+		Name: TranslatedString{
+			language.German:  "Allerheiligen",
+			language.English: "All Saints' Day",
+		},
+		Rule:        Fixed(time.November, 1),
+		Description: regionalStatutory,
+		Periods:     always(RegionalPublicHoliday, BadenWürttemberg, Bayern, NordrheinWestfalen, RheinlandPfalz, Saarland),
+	},
+	{
+		ID: "st-martins-day",
+		Name: TranslatedString{
+			language.German:  "St. Martin",
+			language.English: "St. Martin's Day",
+		},
+		Rule:        Fixed(time.November, 11),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "buss-und-bettag",
+		Name: TranslatedString{
+			language.German:  "Buß- und Bettag",
+			language.English: "Day of Repentance and Prayer",
+		},
+		Rule:        WeekdayBefore(time.Wednesday, time.November, 23),
+		Description: regionalStatutory,
+		Periods: []Period{
+			{From: 1995, Kind: RegionalPublicHoliday, Regions: []Region{Sachsen}},
+			{Till: 1994, Kind: PublicHoliday, Nationwide: true, Description: statutory},
+		},
+	},
+	{
+		ID: "volkstrauertag",
+		Name: TranslatedString{
+			language.German:  "Volkstrauertag",
+			language.English: "Volkstrauertag",
+		},
+		Rule:        Relative("totensonntag", -7),
+		Description: commemoration,
+		Periods:     always(Commemoration),
+	},
+	{
+		ID: "totensonntag",
+		Name: TranslatedString{
+			language.German:  "Totensonntag",
+			language.English: "Totensonntag",
+		},
+		Rule:        Relative("first-advent", -7),
+		Description: commemoration,
+		Periods:     always(Commemoration),
+	},
+	{
+		ID: "saint-nicholas-day",
+		Name: TranslatedString{
+			language.German:  "Nikolaustag",
+			language.English: "Saint Nicholas Day",
+		},
+		Rule:        Fixed(time.December, 6),
+		Description: commemoration,
+		Periods:     always(Observance),
+	},
+	{
+		ID: "first-advent",
+		Name: TranslatedString{
+			language.German:  "1. Advent",
+			language.English: "First Sunday of Advent",
+		},
+		Rule:    Relative("second-advent", -7),
+		Periods: always(Observance),
+	},
+	{
+		ID: "second-advent",
+		Name: TranslatedString{
+			language.German:  "2. Advent",
+			language.English: "Second Sunday of Advent",
+		},
+		Rule:    Relative("third-advent", -7),
+		Periods: always(Observance),
+	},
+	{
+		ID: "third-advent",
+		Name: TranslatedString{
+			language.German:  "3. Advent",
+			language.English: "Third Sunday of Advent",
+		},
+		Rule:    Relative("fourth-advent", -7),
+		Periods: always(Observance),
+	},
+	{
+		ID: "fourth-advent",
+		Name: TranslatedString{
+			language.German:  "4. Advent",
+			language.English: "Fourth Sunday of Advent",
+		},
+		Rule:    WeekdayBefore(time.Sunday, time.December, 25),
+		Periods: always(Observance),
+	},
+	{
+		ID: "christmas-eve",
+		Name: TranslatedString{
+			language.German:  "Heiligabend",
+			language.English: "Christmas Eve",
+		},
+		Rule:    Fixed(time.December, 24),
+		Periods: always(Observance),
+	},
+	{
+		ID: "first-christmas-day",
+		Name: TranslatedString{
+			language.German:  "1. Weihnachtsfeiertag",
+			language.English: "Christmas Day",
+		},
+		Rule:        Fixed(time.December, 25),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "second-christmas-day",
+		Name: TranslatedString{
+			language.German:  "2. Weihnachtsfeiertag",
+			language.English: "Second Day of Christmas",
+		},
+		Rule:        Fixed(time.December, 26),
+		Description: statutory,
+		Periods:     always(PublicHoliday),
+	},
+	{
+		ID: "silvester",
+		Name: TranslatedString{
+			language.German:  "Silvester",
+			language.English: "New Year's Eve",
+		},
+		Rule:    Fixed(time.December, 31),
+		Periods: always(Observance),
+	},
+}
@@ -0,0 +1,151 @@
+package holidays
+
+import (
+	"sync"
+	"time"
+)
+
+// BusinessCalendar computes business days, i.e. days that are neither on a
+// weekend nor a public holiday in a region. An empty region only considers
+// nationwide public holidays.
+type BusinessCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays *Set
+
+	mu    sync.Mutex
+	years map[int][]dayOff
+}
+
+// maxCachedYears limits the memory used by the days off cached by a
+// calendar, which are cleared when the limit is reached.
+const maxCachedYears = 200
+
+// dayOff is the date of a public holiday and where it is observed.
+type dayOff struct {
+	date       time.Time
+	nationwide bool
+	regions    []Region
+}
+
+func (d dayOff) appliesTo(region Region) bool {
+	return (Holiday{Nationwide: d.nationwide, Regions: d.regions}).AppliesTo(region)
+}
+
+// NewBusinessCalendar returns a calendar based on the built-in holidays. The
+// weekend defaults to Saturday and Sunday if no weekend days are given. It
+// panics if every day of the week is a weekend day, as there would be no
+// business days at all.
+func NewBusinessCalendar(weekend ...time.Weekday) *BusinessCalendar {
+	return Builtin().BusinessCalendar(weekend...)
+}
+
+// BusinessCalendar returns a calendar based on the holidays of the set. The
+// weekend defaults to Saturday and Sunday if no weekend days are given. It
+// panics if every day of the week is a weekend day.
+func (s *Set) BusinessCalendar(weekend ...time.Weekday) *BusinessCalendar {
+	if len(weekend) == 0 {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	c := &BusinessCalendar{
+		weekend:  map[time.Weekday]bool{},
+		holidays: s,
+		years:    map[int][]dayOff{},
+	}
+	for _, day := range weekend {
+		c.weekend[day] = true
+	}
+	for day := time.Sunday; c.weekend[day]; day++ {
+		if day == time.Saturday {
+			panic("holidays: every day of the week is a weekend day")
+		}
+	}
+
+	return c
+}
+
+// daysOff returns the public holidays of the year. Only their dates and
+// regions are cached to keep the cache small.
+func (c *BusinessCalendar) daysOff(year int) []dayOff {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	days, ok := c.years[year]
+	if !ok {
+		for _, h := range c.holidays.HolidaysForYear(year) {
+			if h.Kind.IsPublic() {
+				days = append(days, dayOff{date: h.Date, nationwide: h.Nationwide, regions: h.Regions})
+			}
+		}
+		if len(c.years) >= maxCachedYears {
+			c.years = map[int][]dayOff{}
+		}
+		c.years[year] = days
+	}
+	return days
+}
+
+// IsBusinessDay reports whether the date is a business day in the region.
+func (c *BusinessCalendar) IsBusinessDay(date time.Time, region Region) bool {
+	date = day(date)
+	if c.weekend[date.Weekday()] {
+		return false
+	}
+
+	for _, d := range c.daysOff(date.Year()) {
+		if d.date.Equal(date) && d.appliesTo(region) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AddBusinessDays returns the date n business days after the given one, or
+// before it if n is negative. The date itself is returned for n == 0.
+func (c *BusinessCalendar) AddBusinessDays(date time.Time, n int, region Region) time.Time {
+	date = day(date)
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		if c.IsBusinessDay(date, region) {
+			n--
+		}
+	}
+
+	return date
+}
+
+// BusinessDaysBetween counts the business days from a up to but excluding b.
+// The count is negative if b lies before a.
+func (c *BusinessCalendar) BusinessDaysBetween(a, b time.Time, region Region) int {
+	a, b = day(a), day(b)
+
+	sign := 1
+	if b.Before(a) {
+		a, b, sign = b, a, -1
+	}
+
+	var count int
+	for date := a; date.Before(b); date = date.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(date, region) {
+			count++
+		}
+	}
+
+	return sign * count
+}
+
+// NextBusinessDay returns the first business day after the date.
+func (c *BusinessCalendar) NextBusinessDay(date time.Time, region Region) time.Time {
+	return c.AddBusinessDays(date, 1, region)
+}
+
+// day returns the date at midnight UTC as used for holidays.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,116 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	c := NewBusinessCalendar()
+
+	testCases := []struct {
+		date   time.Time
+		region Region
+		want   bool
+	}{
+		{date(2025, 6, 18), Bayern, true},
+		{date(2025, 6, 19), Bayern, false}, // Fronleichnam
+		{date(2025, 6, 19), Hamburg, true},
+		{date(2025, 6, 19), "", true},
+		{date(2025, 6, 21), Hamburg, false}, // Saturday
+		{date(2025, 12, 25), "", false},
+		{date(2025, 2, 14), Bayern, true},    // Valentinstag
+		{date(2025, 8, 15), Saarland, false}, // Mariä Himmelfahrt
+		{date(2025, 8, 15), Sachsen, true},
+		{date(2025, 8, 15), Thüringen, true},
+		{date(2024, 8, 8), Bayern, true}, // Augsburger Hohes Friedensfest
+		{time.Date(2025, 10, 3, 15, 30, 0, 0, time.Local), Berlin, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.date.Format("2006-01-02")+" "+string(tc.region), func(t *testing.T) {
+			if got := c.IsBusinessDay(tc.date, tc.region); got != tc.want {
+				t.Errorf("got %t; want %t", got, tc.want)
+			}
+		})
+	}
+
+	friday := NewBusinessCalendar(time.Friday, time.Saturday)
+	if friday.IsBusinessDay(date(2025, 6, 20), Hamburg) {
+		t.Error("Friday should be a weekend day")
+	}
+	if !friday.IsBusinessDay(date(2025, 6, 22), Hamburg) {
+		t.Error("Sunday should be a business day")
+	}
+}
+
+func TestNoBusinessDays(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for a week without business days")
+		}
+	}()
+	NewBusinessCalendar(0, 1, 2, 3, 4, 5, 6)
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	c := NewBusinessCalendar()
+
+	testCases := []struct {
+		date   time.Time
+		n      int
+		region Region
+		want   time.Time
+	}{
+		{date(2025, 6, 18), 1, Bayern, date(2025, 6, 20)},
+		{date(2025, 6, 18), 1, Hamburg, date(2025, 6, 19)},
+		{date(2025, 12, 23), 2, Bayern, date(2025, 12, 29)},
+		{date(2025, 12, 29), -2, Bayern, date(2025, 12, 23)},
+		{date(2025, 12, 31), 1, Bayern, date(2026, 1, 2)},
+		{date(2025, 6, 21), 0, Bayern, date(2025, 6, 21)},
+	}
+
+	for _, tc := range testCases {
+		got := c.AddBusinessDays(tc.date, tc.n, tc.region)
+		if got != tc.want {
+			t.Errorf("%s %+d: got %s; want %s", tc.date.Format("2006-01-02"), tc.n, got.Format("2006-01-02"), tc.want.Format("2006-01-02"))
+		}
+	}
+
+	if got := c.NextBusinessDay(date(2025, 4, 17), Sachsen); got != date(2025, 4, 22) {
+		t.Errorf("got %s; want 2025-04-22", got.Format("2006-01-02"))
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	c := NewBusinessCalendar()
+
+	// 23 weekdays in December 2025, two of them Christmas holidays
+	if got := c.BusinessDaysBetween(date(2025, 12, 1), date(2026, 1, 1), Bayern); got != 21 {
+		t.Errorf("got %d; want 21", got)
+	}
+	if got := c.BusinessDaysBetween(date(2026, 1, 1), date(2025, 12, 1), Bayern); got != -21 {
+		t.Errorf("got %d; want -21", got)
+	}
+	if got := c.BusinessDaysBetween(date(2025, 6, 16), date(2025, 6, 23), Bayern); got != 4 {
+		t.Errorf("got %d; want 4", got)
+	}
+}
+
+func TestBusinessCalendarCache(t *testing.T) {
+	c := NewBusinessCalendar()
+
+	for year := 1; year <= 3*maxCachedYears; year++ {
+		c.IsBusinessDay(date(year, 12, 25), "")
+	}
+	if len(c.years) > maxCachedYears {
+		t.Errorf("got %d cached years; want at most %d", len(c.years), maxCachedYears)
+	}
+	if c.IsBusinessDay(date(2025, 12, 25), "") {
+		t.Error("Christmas should not be a business day")
+	}
+}
@@ -0,0 +1,163 @@
+package holidays
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Translations to languages other than German and English are kept in
+// gettext PO files, one per language, so that they can be maintained
+// without touching any Go code. The message IDs are the German texts.
+//
+//go:embed locales/*.po
+var locales embed.FS
+
+// catalogs maps languages to the translations of German texts.
+var catalogs = map[language.Tag]map[string]string{}
+
+func init() {
+	files, err := locales.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, file := range files {
+		f, err := locales.Open(path.Join("locales", file.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		lang := language.Make(strings.TrimSuffix(file.Name(), ".po"))
+		if err := RegisterTranslations(lang, f); err != nil {
+			panic(fmt.Errorf("%s: %w", file.Name(), err))
+		}
+		f.Close()
+	}
+}
+
+// RegisterTranslations adds the translations of a gettext PO file to the
+// catalog of the language. The message IDs are the German texts of names and
+// descriptions. Fuzzy and empty translations are ignored.
+func RegisterTranslations(lang language.Tag, r io.Reader) error {
+	messages, err := parsePO(r)
+	if err != nil {
+		return err
+	}
+
+	catalog, ok := catalogs[lang]
+	if !ok {
+		catalog = map[string]string{}
+		catalogs[lang] = catalog
+	}
+	for id, text := range messages {
+		catalog[id] = text
+	}
+
+	return nil
+}
+
+// Languages returns all languages holidays are translated to, German first.
+func Languages() []language.Tag {
+	tags := TranslatedString{language.German: "", language.English: ""}
+	for lang := range catalogs {
+		tags[lang] = ""
+	}
+	return tags.Languages()
+}
+
+// withCatalogs returns a copy of the string extended by the translations of
+// its German text found in the catalogs.
+func (t TranslatedString) withCatalogs() TranslatedString {
+	source, ok := t[language.German]
+	if !ok {
+		return t
+	}
+
+	translations := TranslatedString{}
+	for lang, catalog := range catalogs {
+		if text, ok := catalog[source]; ok {
+			translations[lang] = text
+		}
+	}
+	for lang, text := range t {
+		translations[lang] = text
+	}
+
+	return translations
+}
+
+// parsePO reads the messages of a PO file. Only the subset of the format
+// needed for plain translations is supported, i.e. no plural forms and no
+// message contexts.
+func parsePO(r io.Reader) (map[string]string, error) {
+	messages := map[string]string{}
+
+	var (
+		id, text string
+		fuzzy    bool
+		field    *string
+		number   int
+	)
+	flush := func() {
+		if id != "" && text != "" && !fuzzy {
+			messages[id] = text
+		}
+		id, text, fuzzy, field = "", "", false, nil
+	}
+	appendString := func(quoted string) error {
+		if field == nil {
+			return fmt.Errorf("line %d: string outside of a message", number)
+		}
+		s, err := strconv.Unquote(quoted)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid string %s", number, quoted)
+		}
+		*field += s
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		number++
+		line := strings.TrimSpace(scanner.Text())
+
+		var err error
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#,"):
+			fuzzy = fuzzy || strings.Contains(line, "fuzzy")
+		case strings.HasPrefix(line, "#"):
+			// Comment
+		case strings.HasPrefix(line, "msgid "):
+			if field != nil {
+				flush()
+			}
+			field = &id
+			err = appendString(strings.TrimPrefix(line, "msgid "))
+		case strings.HasPrefix(line, "msgstr "):
+			field = &text
+			err = appendString(strings.TrimPrefix(line, "msgstr "))
+		case strings.HasPrefix(line, `"`):
+			err = appendString(line)
+		default:
+			err = fmt.Errorf("line %d: unsupported syntax", number)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+
+	return messages, nil
+}
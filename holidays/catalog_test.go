@@ -0,0 +1,91 @@
+package holidays
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParsePO(t *testing.T) {
+	const po = `# Comment
+msgid ""
+msgstr ""
+"Language: xx\n"
+
+msgid "Ostern"
+msgstr "Easter"
+
+#, fuzzy
+msgid "Pfingsten"
+msgstr "Pentecost"
+
+msgid "Heilige "
+"Drei Könige"
+msgstr "Epi\"phany\""
+
+msgid "Silvester"
+msgstr ""
+`
+
+	messages, err := parsePO(strings.NewReader(po))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"Ostern":              "Easter",
+		"Heilige Drei Könige": `Epi"phany"`,
+	}
+	if len(messages) != len(want) {
+		t.Errorf("got %v; want %v", messages, want)
+	}
+	for id, text := range want {
+		if messages[id] != text {
+			t.Errorf("%s: got %q; want %q", id, messages[id], text)
+		}
+	}
+
+	if _, err := parsePO(strings.NewReader(`msgctxt "x"`)); err == nil {
+		t.Error("expected error for unsupported syntax")
+	}
+}
+
+// TestCatalogs checks that every catalog translates all names, descriptions,
+// states and kinds.
+func TestCatalogs(t *testing.T) {
+	sources := map[string]bool{}
+	for _, d := range Builtin().Definitions() {
+		sources[d.Name[language.German]] = true
+		if text, ok := d.Description[language.German]; ok {
+			sources[text] = true
+		}
+		for _, p := range d.Periods {
+			if text, ok := p.Description[language.German]; ok {
+				sources[text] = true
+			}
+		}
+	}
+	for _, r := range AllRegions {
+		sources[r.Name()[language.German]] = true
+	}
+	for _, k := range AllKinds {
+		sources[k.Name()[language.German]] = true
+	}
+
+	for lang, catalog := range catalogs {
+		for source := range sources {
+			if _, ok := catalog[source]; !ok {
+				t.Errorf("%s: missing translation of %q", lang, source)
+			}
+		}
+	}
+}
+
+func TestTranslatedDescription(t *testing.T) {
+	got := WomensDay(2023).Description.Translate(language.French)
+	want := "Jour férié en Berlin, Mecklembourg-Poméranie-Occidentale"
+	if got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,229 @@
+package holidays
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Definition describes a holiday independently of the year.
+type Definition struct {
+	ID          string           `json:"id"`
+	Name        TranslatedString `json:"name"`
+	Description TranslatedString `json:"description,omitempty"`
+	Rule        Rule             `json:"rule"`
+	// Periods describe where and how the holiday is observed over the
+	// years. All periods containing a year apply, each to the regions not
+	// covered by an earlier one, e.g. a public holiday in some states
+	// followed by a nationwide commemoration for the others.
+	Periods []Period `json:"periods"`
+}
+
+// Validate checks the definition on its own. References to other holidays
+// are checked by NewSet.
+func (d Definition) Validate() error {
+	if d.ID == "" {
+		return fmt.Errorf("definition without ID")
+	}
+	if len(d.Name) == 0 {
+		return fmt.Errorf("%s: missing name", d.ID)
+	}
+	if err := d.Rule.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", d.ID, err)
+	}
+	if len(d.Periods) == 0 {
+		return fmt.Errorf("%s: missing periods", d.ID)
+	}
+	for _, p := range d.Periods {
+		if p.From != 0 && p.Till != 0 && p.From > p.Till {
+			return fmt.Errorf("%s: period ends before it starts", d.ID)
+		}
+		for _, r := range p.Regions {
+			if !r.IsValid() {
+				return fmt.Errorf("%s: unknown region %s", d.ID, r)
+			}
+		}
+	}
+	return nil
+}
+
+// Set is a collection of holiday definitions with unique IDs.
+type Set struct {
+	definitions []Definition
+	index       map[string]int
+}
+
+// NewSet validates the definitions and returns them as a set. Relative rules
+// must refer to a holiday within the set and must not form a cycle.
+func NewSet(definitions ...Definition) (*Set, error) {
+	s := &Set{index: map[string]int{}}
+
+	for _, d := range definitions {
+		if err := d.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := s.index[d.ID]; ok {
+			return nil, fmt.Errorf("duplicate definition of %s", d.ID)
+		}
+		s.index[d.ID] = len(s.definitions)
+		s.definitions = append(s.definitions, d)
+	}
+
+	for _, d := range s.definitions {
+		visited := map[string]bool{}
+		for r := d.Rule; r.Type == RuleRelative; {
+			if visited[r.Base] {
+				return nil, fmt.Errorf("%s: cyclic reference to %s", d.ID, r.Base)
+			}
+			visited[r.Base] = true
+
+			base, ok := s.Lookup(r.Base)
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown base holiday %s", d.ID, r.Base)
+			}
+			r = base.Rule
+		}
+	}
+
+	return s, nil
+}
+
+func mustSet(definitions ...Definition) *Set {
+	s, err := NewSet(definitions...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// With returns a new set containing the definitions of s followed by the
+// given ones.
+func (s *Set) With(definitions ...Definition) (*Set, error) {
+	return NewSet(append(s.Definitions(), definitions...)...)
+}
+
+// Definitions returns the definitions of the set in their original order.
+func (s *Set) Definitions() []Definition {
+	return append([]Definition(nil), s.definitions...)
+}
+
+// Lookup returns the definition with the given ID.
+func (s *Set) Lookup(id string) (Definition, bool) {
+	i, ok := s.index[id]
+	if !ok {
+		return Definition{}, false
+	}
+	return s.definitions[i], true
+}
+
+// Date computes the date of the holiday with the given ID in the year.
+func (s *Set) Date(id string, year int) (time.Time, error) {
+	d, ok := s.Lookup(id)
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown holiday %s", id)
+	}
+	return d.Rule.date(year, s.Date)
+}
+
+// Holiday returns the holiday with the given ID as observed by the first
+// period containing the year. The holiday is returned even if it isn't
+// observed anywhere in that year, but then without a description, as that
+// only describes where and how it is observed.
+func (s *Set) Holiday(id string, year int) (Holiday, error) {
+	h, periods, err := s.holiday(id, year)
+	if err != nil {
+		return Holiday{}, err
+	}
+	if hs := observe(h, year, periods...); len(hs) > 0 {
+		return hs[0], nil
+	}
+	h.Description = nil
+	return h, nil
+}
+
+// Holidays returns the holiday with the given ID once for every period
+// observing it in the year, each with the kind and regions of its period.
+func (s *Set) Holidays(id string, year int) ([]Holiday, error) {
+	h, periods, err := s.holiday(id, year)
+	if err != nil {
+		return nil, err
+	}
+	return observe(h, year, periods...), nil
+}
+
+// holiday returns the holiday with the given ID in the year before any of
+// its periods are applied.
+func (s *Set) holiday(id string, year int) (Holiday, []Period, error) {
+	d, ok := s.Lookup(id)
+	if !ok {
+		return Holiday{}, nil, fmt.Errorf("unknown holiday %s", id)
+	}
+
+	date, err := d.Rule.date(year, s.Date)
+	if err != nil {
+		return Holiday{}, nil, fmt.Errorf("%s: %w", id, err)
+	}
+
+	return Holiday{
+		ID:          d.ID,
+		Name:        d.Name.withCatalogs(),
+		Date:        date,
+		Kind:        Observance,
+		Description: d.Description,
+	}, d.Periods, nil
+}
+
+func (s *Set) mustHoliday(id string, year int) Holiday {
+	h, err := s.Holiday(id, year)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// HolidaysForYear returns the holidays observed in the year sorted by date.
+// Holidays whose rule can't be evaluated for the year are left out.
+func (s *Set) HolidaysForYear(year int) []Holiday {
+	holidays := []Holiday{}
+
+	for _, d := range s.definitions {
+		hs, err := s.Holidays(d.ID, year)
+		if err != nil {
+			continue
+		}
+		holidays = append(holidays, hs...)
+	}
+
+	// Holidays on the same date keep the order of their definitions
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+
+	return holidays
+}
+
+// HolidaysForRegion returns the public holidays of the year in the given
+// region, i.e. the nationwide public holidays and the regional ones of the
+// region.
+func (s *Set) HolidaysForRegion(year int, region Region) []Holiday {
+	return s.holidaysForRegion(year, region, false)
+}
+
+// HolidaysAndObservancesForRegion returns the public holidays of the year in
+// the given region like HolidaysForRegion plus the observances,
+// commemorations and clock changes that apply there.
+func (s *Set) HolidaysAndObservancesForRegion(year int, region Region) []Holiday {
+	return s.holidaysForRegion(year, region, true)
+}
+
+func (s *Set) holidaysForRegion(year int, region Region, observances bool) []Holiday {
+	holidays := []Holiday{}
+
+	for _, holiday := range s.HolidaysForYear(year) {
+		if holiday.AppliesTo(region) && (observances || holiday.Kind.IsPublic()) {
+			holidays = append(holidays, holiday)
+		}
+	}
+
+	return holidays
+}
@@ -7,8 +7,8 @@ import "time"
 func easterOffset(year int) int {
 	x := year
 	k := x / 100
-	m := 15 + (3*k)/4 - (8*k+13)/25
-	s := 2 - (3*k)/4
+	m := 15 + (3*k+3)/4 - (8*k+13)/25
+	s := 2 - (3*k+3)/4
 	a := x % 19
 	d := (19*a + m) % 30
 	r := (d + a/11) / 29
@@ -1,7 +1,8 @@
 package holidays
 
 import (
-	"sort"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"golang.org/x/text/language"
@@ -11,611 +12,270 @@ type (
 	TranslatedString map[language.Tag]string
 
 	Holiday struct {
+		// ID identifies the holiday independently of the year and the
+		// language, e.g. "easter-monday".
+		ID          string
 		Name        TranslatedString
 		Date        time.Time
 		Description TranslatedString
+		Kind        Kind
+		// Nationwide is set if the holiday applies in all of Germany.
+		Nationwide bool
+		// Regions lists the ISO 3166-2 codes of the states the holiday
+		// applies to if it is not nationwide.
+		Regions []Region
 	}
 )
 
 func NewYear(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Neujahrstag",
-			language.English: "New Year",
-		},
-		Date: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("new-year", year)
 }
 
 func Epiphany(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Heilige Drei Könige",
-		},
-		Date: time.Date(year, 1, 6, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Feiertag in Baden-Württemberg, Bayern, Sachsen-Anhalt0",
-		},
-	}
+	return builtin.mustHoliday("epiphany", year)
 }
 
 func ValentinesDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Valentinstag",
-		},
-		Date: time.Date(year, 2, 14, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("valentines-day", year)
 }
 
 func Rosenmontag(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Rosenmontag",
-		},
-		Date: easterDate(year).AddDate(0, 0, -48),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("rosenmontag", year)
 }
 
 func ShrowveTuesday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Faschingsdienstag",
-			language.English: "Shrove Tuesday",
-		},
-		Date: easterDate(year).AddDate(0, 0, -47),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("shrove-tuesday", year)
 }
 
 func AshWednesday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Aschermittwoch",
-			language.English: "Ash Wednesday",
-		},
-		Date: easterDate(year).AddDate(0, 0, -46),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("ash-wednesday", year)
 }
 
 func WomensDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Internationaler Frauentag",
-		},
-		Date: time.Date(year, 3, 8, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Baden-Württemberg, Bayern, Berlin, Brandenburg, Bremen, Hamburg, Hessen, Mecklenburg-Vorpommern, Mecklenburg-Vorpommern, Niedersachsen, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland, Sachsen, Sachsen-Anhalt, Schleswig-Holstein, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("womens-day", year)
 }
 
 func StartOfDST(year int) Holiday {
-	date := time.Date(year, 3, 31, 0, 0, 0, 0, time.UTC)
-
-	for {
-		if date.Weekday() == time.Sunday {
-			return Holiday{
-				Name: TranslatedString{
-					language.German: "Beginn der Sommerzeit",
-				},
-				Date: date,
-			}
-		}
-		date = date.AddDate(0, 0, -1)
-	}
+	return builtin.mustHoliday("start-of-dst", year)
 }
 
 func PalmSunday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Palmsonntag",
-			language.English: "Palm Sunday",
-		},
-		Date: easterDate(year).AddDate(0, 0, -7),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("palm-sunday", year)
 }
 
 func MaundyThursday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Gründonnerstag",
-		},
-		Date: easterDate(year).AddDate(0, 0, -3),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Baden-Württemberg, Bayern, Berlin, Brandenburg, Bremen, Hamburg, Hessen, Mecklenburg-Vorpommern, Niedersachsen, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland, Sachsen, Sachsen-Anhalt, Schleswig-Holstein, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("maundy-thursday", year)
 }
 
 func GoodFriday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Karfreitag",
-		},
-		Date: easterDate(year).AddDate(0, 0, -2),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("good-friday", year)
 }
 
 func HolySaturday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Karsamstag",
-			language.English: "Holy Saturday",
-		},
-		Date: easterDate(year).AddDate(0, 0, -1),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Bayern, Hessen, Niedersachsen, Saarland, Rheinland-Pfalz",
-		},
-	}
+	return builtin.mustHoliday("holy-saturday", year)
 }
 
 func Easter(year int) Holiday {
-	reference := time.Date(year, time.March, 0, 0, 0, 0, 0, time.UTC)
-	date := reference.AddDate(0, 0, easterOffset(year))
-
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Ostern",
-			language.English: "Easter",
-		},
-		Date: date,
-		Description: TranslatedString{
-			language.German: "Gedenktag in Baden-Württemberg, Bayern, Berlin, Brandenburg, Bremen, Hamburg, Hessen, Mecklenburg-Vorpommern, Niedersachsen, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland, Sachsen, Sachsen-Anhalt, Schleswig-Holstein, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("easter", year)
 }
 
 func EasterMonday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Ostermontag",
-		},
-		Date: easterDate(year).AddDate(0, 0, 1),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("easter-monday", year)
 }
 
 func WorkersDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Tag der Arbeit",
-		},
-		Date: time.Date(year, 5, 1, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("workers-day", year)
 }
 
 func VictoryInEuropeDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Jahrestag der Befreiung vom Nationalsozialismus",
-			language.English: "Victory in Europe Day",
-		},
-		Date: time.Date(year, 5, 8, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Berlin, Brandenburg, Bremen, Mecklenburg-Vorpommern, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("victory-in-europe-day", year)
 }
 
-// Second Sunday of May
 func MothersDay(year int) Holiday {
-	var count int
-	date := time.Date(year, 5, 1, 0, 0, 0, 0, time.UTC)
-
-	for {
-		if date.Weekday() == time.Sunday {
-			count++
-		}
-		if count == 2 {
-			return Holiday{
-				Name: TranslatedString{
-					language.German:  "Muttertag",
-					language.English: "Mother's Day",
-				},
-				Date: date,
-				Description: TranslatedString{
-					language.German: "Gedenktag",
-				},
-			}
-		}
-		date = date.AddDate(0, 0, 1)
-	}
+	return builtin.mustHoliday("mothers-day", year)
 }
 
 func FeastOfTheAscension(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Christi Himmelfahrt",
-		},
-		Date: easterDate(year).AddDate(0, 0, 39),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("ascension-day", year)
 }
 
 func FathersDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Vatertag",
-			language.English: "Father's Day",
-		},
-		Date: easterDate(year).AddDate(0, 0, 39),
-	}
+	return builtin.mustHoliday("fathers-day", year)
 }
 
 func Pentecost(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Pfingsten",
-		},
-		Date: easterDate(year).AddDate(0, 0, 49),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Baden-Württemberg, Bayern, Berlin, Brandenburg, Bremen, Hamburg, Hessen, Mecklenburg-Vorpommern, Niedersachsen, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland, Sachsen, Sachsen-Anhalt, Schleswig-Holstein, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("pentecost", year)
 }
 
 func PentecostMonday(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Pfingstmontag",
-		},
-		Date: easterDate(year).AddDate(0, 0, 50),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("pentecost-monday", year)
 }
 
 func FeastOfCorpusChristi(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Fronleichnam",
-		},
-		Date: easterDate(year).AddDate(0, 0, 60),
-		Description: TranslatedString{
-			language.German: "Feiertag in Baden-Württemberg, Bayern, Hessen, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland, Sachsen, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("corpus-christi", year)
 }
 
 func AugsburgerHohesFriedensfest(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Augsburger Hohes Friedensfest",
-		},
-		Date: time.Date(year, 8, 8, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Feiertag in Bayern",
-		},
-	}
+	return builtin.mustHoliday("augsburger-hohes-friedensfest", year)
 }
 
 func AssumptionOfMary(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Mariä Himmelfahrt",
-		},
-		Date: time.Date(year, 8, 15, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag in Bayern, Saarland, Sachsen, Thüringen",
-		},
-	}
+	return builtin.mustHoliday("assumption-of-mary", year)
 }
 
 func ChildrensDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Weltkindertag",
-		},
-		Date: time.Date(year, 9, 20, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Feiertag in Thüringen",
-		},
-	}
+	return builtin.mustHoliday("childrens-day", year)
 }
 
 func GermanUnityDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Tag der Deutschen Einheit",
-		},
-		Date: time.Date(year, 10, 3, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("german-unity-day", year)
 }
 
 func EndOfDST(year int) Holiday {
-	date := time.Date(year, 10, 31, 0, 0, 0, 0, time.UTC)
-
-	for {
-		if date.Weekday() == time.Sunday {
-			return Holiday{
-				Name: TranslatedString{
-					language.German: "Ende der Sommerzeit",
-				},
-				Date: date,
-			}
-		}
-		date = date.AddDate(0, 0, -1)
-	}
+	return builtin.mustHoliday("end-of-dst", year)
 }
 
 func ReformationDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Reformationstag",
-		},
-		Date: time.Date(year, 10, 31, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Feiertag in Brandenburg, Mecklenburg-Vorpommern, Sachsen, Sachsen-Anhalt, Thüringen, Schleswig-Holstein, Hamburg, Niedersachsen, Bremen",
-		},
-	}
+	return builtin.mustHoliday("reformation-day", year)
 }
 
 func Halloween(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Halloween",
-			language.English: "Halloween",
-		},
-		Date: time.Date(year, 10, 31, 0, 0, 0, 0, time.UTC),
-	}
+	return builtin.mustHoliday("halloween", year)
 }
 
 func AllSaintsDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Allerheiligen",
-		},
-		Date: time.Date(year, 11, 1, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Feiertag in Baden-Württemberg, Bayern, Nordrhein-Westfalen, Rheinland-Pfalz, Saarland",
-		},
-	}
+	return builtin.mustHoliday("all-saints-day", year)
 }
 
 func StMartinsDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "St. Martin",
-			language.English: "St. Martin's Day",
-		},
-		Date: time.Date(year, 11, 11, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("st-martins-day", year)
 }
 
 func BußUndBettag(year int) Holiday {
-	date := time.Date(year, 11, 22, 0, 0, 0, 0, time.UTC)
-
-	for {
-		if date.Weekday() == time.Wednesday {
-			return Holiday{
-				Name: TranslatedString{
-					language.German: "Buß- und Bettag",
-				},
-				Date: date,
-				Description: TranslatedString{
-					language.German: "Feiertag in Sachsen",
-				},
-			}
-		}
-		date = date.AddDate(0, 0, -1)
-	}
+	return builtin.mustHoliday("buss-und-bettag", year)
 }
 
 func Volkstrauertag(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Volkstrauertag",
-			language.English: "Volkstrauertag",
-		},
-		Date: Totensonntag(year).Date.AddDate(0, 0, -7),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("volkstrauertag", year)
 }
 
 func Totensonntag(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Totensonntag",
-			language.English: "Totensonntag",
-		},
-		Date: FirstAdvent(year).Date.AddDate(0, 0, -7),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("totensonntag", year)
 }
 
 func SaintNicholasDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German:  "Nikolaustag",
-			language.English: "Saint Nicholas Day",
-		},
-		Date: time.Date(year, 12, 6, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gedenktag",
-		},
-	}
+	return builtin.mustHoliday("saint-nicholas-day", year)
 }
 
 func FirstAdvent(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "1. Advent",
-		},
-		Date: SecondAdvent(year).Date.AddDate(0, 0, -7),
-	}
+	return builtin.mustHoliday("first-advent", year)
 }
 
 func SecondAdvent(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "2. Advent",
-		},
-		Date: ThirdAdvent(year).Date.AddDate(0, 0, -7),
-	}
+	return builtin.mustHoliday("second-advent", year)
 }
 
 func ThirdAdvent(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "3. Advent",
-		},
-		Date: FourthAdvent(year).Date.AddDate(0, 0, -7),
-	}
+	return builtin.mustHoliday("third-advent", year)
 }
 
 func FourthAdvent(year int) Holiday {
-	date := time.Date(year, 12, 24, 0, 0, 0, 0, time.UTC)
-
-	for {
-		if date.Weekday() == time.Sunday {
-			return Holiday{
-				Name: TranslatedString{
-					language.German: "4. Advent",
-				},
-				Date: date,
-			}
-		}
-		date = date.AddDate(0, 0, -1)
-	}
+	return builtin.mustHoliday("fourth-advent", year)
 }
 
 func ChristmasEve(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Heiligabend",
-		},
-		Date: time.Date(year, 12, 24, 0, 0, 0, 0, time.UTC),
-	}
+	return builtin.mustHoliday("christmas-eve", year)
 }
 
 func FirstChristmasDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "1. Weihnachtsfeiertag",
-		},
-		Date: time.Date(year, 12, 25, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("first-christmas-day", year)
 }
 
 func SecondChristmasDay(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "2. Weihnachtsfeiertag",
-		},
-		Date: time.Date(year, 12, 26, 0, 0, 0, 0, time.UTC),
-		Description: TranslatedString{
-			language.German: "Gesetzlicher Feiertag",
-		},
-	}
+	return builtin.mustHoliday("second-christmas-day", year)
 }
 
 func Silvester(year int) Holiday {
-	return Holiday{
-		Name: TranslatedString{
-			language.German: "Silvester",
-		},
-		Date: time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC),
-	}
+	return builtin.mustHoliday("silvester", year)
 }
 
-var allHolidays = [](func(int) Holiday){
-	NewYear,
-	Epiphany,
-	ValentinesDay,
-	Rosenmontag,
-	ShrowveTuesday,
-	AshWednesday,
-	WomensDay,
-	StartOfDST,
-	PalmSunday,
-	MaundyThursday,
-	GoodFriday,
-	HolySaturday,
-	Easter,
-	EasterMonday,
-	WorkersDay,
-	VictoryInEuropeDay,
-	MothersDay,
-	FeastOfTheAscension,
-	FathersDay,
-	Pentecost,
-	PentecostMonday,
-	FeastOfCorpusChristi,
-	AugsburgerHohesFriedensfest,
-	AssumptionOfMary,
-	ChildrensDay,
-	GermanUnityDay,
-	EndOfDST,
-	ReformationDay,
-	Halloween,
-	AllSaintsDay,
-	StMartinsDay,
-	BußUndBettag,
-	Volkstrauertag,
-	Totensonntag,
-	SaintNicholasDay,
-	FirstAdvent,
-	SecondAdvent,
-	ThirdAdvent,
-	FourthAdvent,
-	ChristmasEve,
-	FirstChristmasDay,
-	SecondChristmasDay,
-	Silvester,
+// builtin holds the definitions of all holidays known to the package.
+var builtin = mustSet(builtinDefinitions...)
+
+// Builtin returns the set of built-in holiday definitions including those
+// added by Register.
+func Builtin() *Set {
+	return builtin
 }
 
 func HolidaysForYear(year int) []Holiday {
-	holidays := []Holiday{}
+	return builtin.HolidaysForYear(year)
+}
+
+// HolidaysForRegion returns the public holidays of the year in the given
+// region, i.e. the nationwide public holidays and the regional ones of the
+// region.
+func HolidaysForRegion(year int, region Region) []Holiday {
+	return builtin.HolidaysForRegion(year, region)
+}
+
+// HolidaysAndObservancesForRegion returns the public holidays of the year in
+// the given region plus the observances, commemorations and clock changes
+// that apply there.
+func HolidaysAndObservancesForRegion(year int, region Region) []Holiday {
+	return builtin.HolidaysAndObservancesForRegion(year, region)
+}
+
+// holidayJSON is the serialized form of a holiday with the date written as
+// ISO 8601 date, e.g. "2025-05-29".
+type holidayJSON struct {
+	ID          string           `json:"id"`
+	Date        string           `json:"date"`
+	Name        TranslatedString `json:"name"`
+	Description TranslatedString `json:"description,omitempty"`
+	Kind        Kind             `json:"kind"`
+	Nationwide  bool             `json:"nationwide"`
+	Regions     []Region         `json:"regions"`
+}
 
-	for _, holiday := range allHolidays {
-		holidays = append(holidays, holiday(year))
+func (h Holiday) MarshalJSON() ([]byte, error) {
+	v := holidayJSON{
+		ID:          h.ID,
+		Date:        h.Date.Format("2006-01-02"),
+		Name:        h.Name,
+		Description: h.Description,
+		Kind:        h.Kind,
+		Nationwide:  h.Nationwide,
+		Regions:     h.Regions,
 	}
+	if v.Regions == nil {
+		v.Regions = []Region{}
+	}
+	return json.Marshal(v)
+}
 
-	sort.Slice(holidays, func(i, j int) bool {
-		return holidays[i].Date.Before(holidays[j].Date)
-	})
+func (h *Holiday) UnmarshalJSON(data []byte) error {
+	var v holidayJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
 
-	return holidays
+	date, err := time.Parse("2006-01-02", v.Date)
+	if err != nil {
+		return fmt.Errorf("invalid date '%s'", v.Date)
+	}
+
+	*h = Holiday{
+		ID:          v.ID,
+		Name:        v.Name,
+		Date:        date,
+		Description: v.Description,
+		Kind:        v.Kind,
+		Nationwide:  v.Nationwide,
+		Regions:     v.Regions,
+	}
+	if len(h.Regions) == 0 {
+		h.Regions = nil
+	}
+	return nil
 }
@@ -1,7 +1,9 @@
 package holidays
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -68,3 +70,384 @@ func TestHolidays(t *testing.T) {
 		})
 	}
 }
+
+func TestEasterDate(t *testing.T) {
+	testCases := []struct {
+		year       int
+		month, day int
+	}{
+		{1818, 3, 22},
+		{1900, 4, 15},
+		{1943, 4, 25},
+		{1954, 4, 18},
+		{1981, 4, 19},
+		{1990, 4, 15},
+		{1999, 4, 4},
+		{2000, 4, 23},
+		{2019, 4, 21},
+		{2025, 4, 20},
+		{2038, 4, 25},
+		{2100, 3, 28},
+		{2285, 3, 22},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.year), func(t *testing.T) {
+			want := time.Date(tc.year, time.Month(tc.month), tc.day, 0, 0, 0, 0, time.UTC)
+			if got := easterDate(tc.year); !got.Equal(want) {
+				t.Errorf("got %s; want %s", got.Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+			if got := easterDate(tc.year).Weekday(); got != time.Sunday {
+				t.Errorf("got %s; want Sunday", got)
+			}
+		})
+	}
+}
+
+func TestAppliesTo(t *testing.T) {
+	testCases := []struct {
+		fn     func(int) Holiday
+		region Region
+		want   bool
+	}{
+		{NewYear, Bayern, true},
+		{Epiphany, Bayern, true},
+		{Epiphany, Berlin, false},
+		{AugsburgerHohesFriedensfest, Bayern, true},
+		{AugsburgerHohesFriedensfest, Hamburg, false},
+		{BußUndBettag, Sachsen, true},
+		{BußUndBettag, Thüringen, false},
+	}
+
+	for _, tc := range testCases {
+		got := tc.fn(2021)
+		t.Run(fmt.Sprintf("%s in %s", got.Name[language.German], tc.region), func(t *testing.T) {
+			if got.AppliesTo(tc.region) != tc.want {
+				t.Errorf("got %t; want %t", !tc.want, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	for _, kind := range AllKinds {
+		t.Run(kind.String(), func(t *testing.T) {
+			got, err := ParseKind(kind.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != kind {
+				t.Errorf("got %s; want %s", got, kind)
+			}
+		})
+	}
+
+	if _, err := ParseKind("bank-holiday"); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestHolidaysForRegion(t *testing.T) {
+	ids := func(hs []Holiday) map[string]bool {
+		ids := map[string]bool{}
+		for _, h := range hs {
+			if !h.AppliesTo(Hamburg) {
+				t.Errorf("%s doesn't apply in %s", h.ID, Hamburg)
+			}
+			ids[h.ID] = true
+		}
+		return ids
+	}
+
+	public := HolidaysForRegion(2025, Hamburg)
+	for _, h := range public {
+		if !h.Kind.IsPublic() {
+			t.Errorf("%s is a %s in %s", h.ID, h.Kind, Hamburg)
+		}
+	}
+	got := ids(public)
+	for _, id := range []string{"new-year", "good-friday", "reformation-day", "second-christmas-day"} {
+		if !got[id] {
+			t.Errorf("%s is missing in %s", id, Hamburg)
+		}
+	}
+	for _, id := range []string{"corpus-christi", "valentines-day", "halloween", "start-of-dst"} {
+		if got[id] {
+			t.Errorf("%s is no public holiday in %s", id, Hamburg)
+		}
+	}
+
+	all := HolidaysAndObservancesForRegion(2025, Hamburg)
+	got = ids(all)
+	for _, id := range []string{"new-year", "reformation-day", "valentines-day", "halloween", "start-of-dst", "womens-day"} {
+		if !got[id] {
+			t.Errorf("%s is missing in %s with observances", id, Hamburg)
+		}
+	}
+	if got["corpus-christi"] {
+		t.Errorf("corpus-christi is no holiday in %s", Hamburg)
+	}
+	if len(all) <= len(public) {
+		t.Errorf("got %d holidays with observances; want more than %d", len(all), len(public))
+	}
+
+	if _, err := ParseRegion("de-by"); err != nil {
+		t.Error(err)
+	}
+	if _, err := ParseRegion("DE-XX"); err == nil {
+		t.Error("expected error for unknown region")
+	}
+}
+
+// TestIDs checks that every holiday has an ID that is unique within each
+// region, as a holiday may be observed differently in different regions.
+func TestIDs(t *testing.T) {
+	for _, h := range HolidaysForYear(2021) {
+		if h.ID == "" {
+			t.Errorf("%s has no ID", h.Name[language.German])
+		}
+	}
+
+	for _, region := range AllRegions {
+		seen := map[string]bool{}
+		for _, h := range HolidaysAndObservancesForRegion(2021, region) {
+			if seen[h.ID] {
+				t.Errorf("duplicate ID %s in %s", h.ID, region)
+			}
+			seen[h.ID] = true
+		}
+	}
+}
+
+func TestHolidaysForYearOrder(t *testing.T) {
+	want := []string{"end-of-dst", "reformation-day", "halloween"}
+
+	for i := 0; i < 10; i++ {
+		got := []string{}
+		for _, h := range HolidaysForYear(2021) {
+			if h.Date.Month() == time.October && h.Date.Day() == 31 {
+				got = append(got, h.ID)
+			}
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Fatalf("got %v; want %v", got, want)
+		}
+	}
+}
+
+func TestPeriods(t *testing.T) {
+	testCases := []struct {
+		fn     func(int) Holiday
+		year   int
+		region Region
+		want   Kind
+	}{
+		{BußUndBettag, 1994, Bayern, PublicHoliday},
+		{BußUndBettag, 1995, Sachsen, RegionalPublicHoliday},
+		{ReformationDay, 2016, Sachsen, RegionalPublicHoliday},
+		{ReformationDay, 2017, Bayern, PublicHoliday},
+		{ReformationDay, 2018, Hamburg, RegionalPublicHoliday},
+		{WomensDay, 2018, Berlin, Commemoration},
+		{WomensDay, 2019, Berlin, RegionalPublicHoliday},
+		{WomensDay, 2023, MecklenburgVorpommern, RegionalPublicHoliday},
+		{ChildrensDay, 2019, Thüringen, RegionalPublicHoliday},
+		{VictoryInEuropeDay, 2020, Berlin, RegionalPublicHoliday},
+		{VictoryInEuropeDay, 2021, Berlin, Commemoration},
+		{VictoryInEuropeDay, 2025, Berlin, RegionalPublicHoliday},
+	}
+
+	for _, tc := range testCases {
+		got := tc.fn(tc.year)
+		t.Run(fmt.Sprintf("%s in %s %d", got.Name[language.German], tc.region, tc.year), func(t *testing.T) {
+			if !got.AppliesTo(tc.region) {
+				t.Fatalf("doesn't apply")
+			}
+			if got.Kind != tc.want {
+				t.Errorf("got %s; want %s", got.Kind, tc.want)
+			}
+		})
+	}
+
+	notObserved := []struct {
+		fn     func(int) Holiday
+		year   int
+		region Region
+	}{
+		{ReformationDay, 2016, Hamburg},
+		{ReformationDay, 1989, Sachsen},
+		{BußUndBettag, 1995, Bayern},
+		{WomensDay, 2022, MecklenburgVorpommern},
+		{ChildrensDay, 2019, Bayern},
+		{GermanUnityDay, 1989, Berlin},
+	}
+
+	for _, tc := range notObserved {
+		got := tc.fn(tc.year)
+		t.Run(fmt.Sprintf("%s not in %s %d", got.Name[language.German], tc.region, tc.year), func(t *testing.T) {
+			if got.AppliesTo(tc.region) {
+				t.Errorf("applies")
+			}
+		})
+	}
+}
+
+func TestOverlappingPeriods(t *testing.T) {
+	testCases := []struct {
+		id     string
+		year   int
+		region Region
+		want   Kind
+	}{
+		{"womens-day", 2018, Bayern, Commemoration},
+		{"womens-day", 2025, Berlin, RegionalPublicHoliday},
+		{"womens-day", 2025, MecklenburgVorpommern, RegionalPublicHoliday},
+		{"womens-day", 2025, Bayern, Commemoration},
+		{"womens-day", 2022, MecklenburgVorpommern, Commemoration},
+		{"childrens-day", 2025, Thüringen, RegionalPublicHoliday},
+		{"childrens-day", 2025, Bayern, Observance},
+		{"victory-in-europe-day", 2025, Brandenburg, Commemoration},
+		{"assumption-of-mary", 2025, Bayern, RegionalPublicHoliday},
+		{"assumption-of-mary", 2025, Saarland, RegionalPublicHoliday},
+		{"assumption-of-mary", 2025, Sachsen, Commemoration},
+		{"assumption-of-mary", 2025, Thüringen, Commemoration},
+		{"augsburger-hohes-friedensfest", 2025, Bayern, Commemoration},
+		{"buss-und-bettag", 1994, Bayern, PublicHoliday},
+		{"buss-und-bettag", 2025, Sachsen, RegionalPublicHoliday},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s in %s %d", tc.id, tc.region, tc.year), func(t *testing.T) {
+			var got []Kind
+			for _, h := range HolidaysAndObservancesForRegion(tc.year, tc.region) {
+				if h.ID == tc.id {
+					got = append(got, h.Kind)
+				}
+			}
+			if len(got) != 1 || got[0] != tc.want {
+				t.Errorf("got %v; want [%s]", got, tc.want)
+			}
+		})
+	}
+
+	for _, h := range HolidaysForRegion(2025, Bayern) {
+		if h.ID == "buss-und-bettag" {
+			t.Errorf("Buß- und Bettag is no holiday in %s since 1995", Bayern)
+		}
+	}
+}
+
+// TestNotObserved checks holidays in years before their first period.
+func TestNotObserved(t *testing.T) {
+	testCases := []struct {
+		fn   func(int) Holiday
+		year int
+	}{
+		{ReformationDay, 1989},
+		{GermanUnityDay, 1980},
+		{ReformationDay, 1900},
+	}
+
+	for _, tc := range testCases {
+		got := tc.fn(tc.year)
+		t.Run(fmt.Sprintf("%s %d", got.ID, tc.year), func(t *testing.T) {
+			if got.IsObserved() {
+				t.Errorf("observed in %v", got.Regions)
+			}
+			if got.Kind != Observance {
+				t.Errorf("got %s; want %s", got.Kind, Observance)
+			}
+			if len(got.Description) > 0 {
+				t.Errorf("got description %q", got.Description.Translate(language.German))
+			}
+		})
+	}
+}
+
+func TestDescriptionRegions(t *testing.T) {
+	got := WomensDay(2023).Description[language.German]
+	want := "Feiertag in Berlin, Mecklenburg-Vorpommern"
+	if got != want {
+		t.Errorf("got %q; want %q", got, want)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	name := TranslatedString{
+		language.German:  "Ostermontag",
+		language.English: "Easter Monday",
+	}
+
+	testCases := []struct {
+		lang string
+		want string
+	}{
+		{"de", "Ostermontag"},
+		{"de-AT", "Ostermontag"},
+		{"en", "Easter Monday"},
+		{"en-GB", "Easter Monday"},
+		{"en-US", "Easter Monday"},
+		{"fr", "Lundi de Pâques"},
+		{"fr-CA", "Lundi de Pâques"},
+		{"ja", "Ostermontag"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.lang, func(t *testing.T) {
+			if got := name.Translate(language.MustParse(tc.lang)); got != tc.want {
+				t.Errorf("got %q; want %q", got, tc.want)
+			}
+		})
+	}
+
+	if _, ok := (TranslatedString{}).Lookup(language.German); ok {
+		t.Error("empty string shouldn't have a translation")
+	}
+}
+
+func TestEnglishNames(t *testing.T) {
+	for _, d := range Builtin().Definitions() {
+		if _, ok := d.Name[language.English]; !ok {
+			t.Errorf("%s has no English name", d.ID)
+		}
+		if _, ok := d.Description[language.German]; ok {
+			if _, ok := d.Description[language.English]; !ok {
+				t.Errorf("%s has no English description", d.ID)
+			}
+		}
+	}
+}
+
+func TestHolidayJSON(t *testing.T) {
+	testCases := []struct {
+		holiday Holiday
+		want    string
+	}{
+		{
+			Holiday{ID: "test", Name: TranslatedString{language.German: "Testtag"}, Date: time.Date(2025, 5, 29, 0, 0, 0, 0, time.UTC), Kind: PublicHoliday, Nationwide: true},
+			`{"id":"test","date":"2025-05-29","name":{"de":"Testtag"},"kind":"public","nationwide":true,"regions":[]}`,
+		},
+		{
+			Holiday{ID: "test", Name: TranslatedString{language.German: "Testtag", language.English: "Test day"}, Date: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+				Description: TranslatedString{language.English: "Holiday in Bavaria"}, Kind: RegionalPublicHoliday, Regions: []Region{Bayern}},
+			`{"id":"test","date":"2025-01-06","name":{"de":"Testtag","en":"Test day"},"description":{"en":"Holiday in Bavaria"},"kind":"regional","nationwide":false,"regions":["DE-BY"]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.holiday)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tc.want {
+			t.Errorf("got %s; want %s", data, tc.want)
+		}
+
+		var got Holiday
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.holiday) {
+			t.Errorf("got %+v; want %+v", got, tc.holiday)
+		}
+	}
+}
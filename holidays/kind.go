@@ -0,0 +1,91 @@
+package holidays
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Kind classifies a holiday by its legal or practical significance.
+type Kind int
+
+const (
+	// Observance is a day without legal significance, e.g. Valentinstag.
+	Observance Kind = iota
+	// PublicHoliday is a statutory holiday in all of Germany.
+	PublicHoliday
+	// RegionalPublicHoliday is a statutory holiday in some states only.
+	RegionalPublicHoliday
+	// Commemoration is a day of remembrance, e.g. Volkstrauertag.
+	Commemoration
+	// ClockChange marks the start or the end of daylight saving time.
+	ClockChange
+)
+
+var kindNames = map[Kind]string{
+	Observance:            "observance",
+	PublicHoliday:         "public",
+	RegionalPublicHoliday: "regional",
+	Commemoration:         "commemoration",
+	ClockChange:           "clock-change",
+}
+
+var kindTitles = map[Kind]TranslatedString{
+	Observance:            {language.German: "Aktionstag", language.English: "Observance"},
+	PublicHoliday:         {language.German: "Gesetzlicher Feiertag", language.English: "Public holiday"},
+	RegionalPublicHoliday: {language.German: "Regionaler Feiertag", language.English: "Regional holiday"},
+	Commemoration:         {language.German: "Gedenktag", language.English: "Commemoration"},
+	ClockChange:           {language.German: "Zeitumstellung", language.English: "Clock change"},
+}
+
+// AllKinds lists every kind of holiday.
+var AllKinds = []Kind{
+	PublicHoliday,
+	RegionalPublicHoliday,
+	Commemoration,
+	Observance,
+	ClockChange,
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Name returns the translated name of the kind, e.g. "Public holiday".
+func (k Kind) Name() TranslatedString {
+	return kindTitles[k]
+}
+
+// IsPublic reports whether holidays of this kind are days off.
+func (k Kind) IsPublic() bool {
+	return k == PublicHoliday || k == RegionalPublicHoliday
+}
+
+func (k Kind) MarshalText() ([]byte, error) {
+	if _, ok := kindNames[k]; !ok {
+		return nil, fmt.Errorf("invalid holiday kind %d", int(k))
+	}
+	return []byte(k.String()), nil
+}
+
+func (k *Kind) UnmarshalText(text []byte) error {
+	kind, err := ParseKind(string(text))
+	if err != nil {
+		return err
+	}
+	*k = kind
+	return nil
+}
+
+// ParseKind returns the kind with the given name as returned by String.
+func ParseKind(s string) (Kind, error) {
+	for k, name := range kindNames {
+		if name == s {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown holiday kind '%s'", s)
+}
@@ -0,0 +1,202 @@
+package holidays
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// Problem is an issue found in holiday definitions by Lint.
+type Problem struct {
+	// ID is the ID of the definition the problem was found in.
+	ID string
+	// Warning is set for problems that don't prevent the definitions from
+	// being used, e.g. missing descriptions.
+	Warning bool
+	Message string
+}
+
+func (p Problem) String() string {
+	severity := "error"
+	if p.Warning {
+		severity = "warning"
+	}
+	return fmt.Sprintf("%s: %s: %s", severity, p.ID, p.Message)
+}
+
+var placeholder = regexp.MustCompile(`\{[^}]*\}`)
+
+// Lint checks the definitions for
+//   - invalid definitions, e.g. with unknown regions, and duplicate IDs,
+//   - names and descriptions missing in any of the languages,
+//   - regions that are mentioned in a description but the holiday never
+//     applies to,
+//   - rules that can't be evaluated for a year from from to till, and
+//   - holidays that fall on the same date in every one of those years.
+func Lint(definitions []Definition, langs []language.Tag, from, till int) []Problem {
+	var problems []Problem
+	report := func(id string, warning bool, format string, args ...interface{}) {
+		problems = append(problems, Problem{ID: id, Warning: warning, Message: fmt.Sprintf(format, args...)})
+	}
+
+	index := map[string]Definition{}
+	var unique []Definition
+	for _, d := range definitions {
+		if _, ok := index[d.ID]; ok {
+			report(d.ID, false, "duplicate ID")
+			continue
+		}
+		index[d.ID] = d
+		unique = append(unique, d)
+	}
+
+	for _, d := range unique {
+		if err := d.Validate(); err != nil {
+			report(d.ID, false, "%s", strings.TrimPrefix(err.Error(), d.ID+": "))
+		}
+		lintTranslations(d, langs, report)
+		lintRegions(d, report)
+	}
+
+	// Evaluate the rules with the first definition of every ID
+	var resolve func(id string, year int, depth int) (time.Time, error)
+	resolve = func(id string, year int, depth int) (time.Time, error) {
+		d, ok := index[id]
+		if !ok {
+			return time.Time{}, fmt.Errorf("unknown base holiday %s", id)
+		}
+		if depth > len(index) {
+			return time.Time{}, fmt.Errorf("cyclic reference to %s", id)
+		}
+		return d.Rule.date(year, func(id string, year int) (time.Time, error) {
+			return resolve(id, year, depth+1)
+		})
+	}
+
+	dates := map[string][]time.Time{}
+	for _, d := range unique {
+		if d.Rule.Validate() != nil {
+			continue
+		}
+		for year := from; year <= till; year++ {
+			date, err := resolve(d.ID, year, 0)
+			if err != nil {
+				report(d.ID, false, "%s", err)
+				delete(dates, d.ID)
+				break
+			}
+			dates[d.ID] = append(dates[d.ID], date)
+		}
+	}
+
+	for i, a := range unique {
+		for _, b := range unique[i+1:] {
+			if collide(dates[a.ID], dates[b.ID]) {
+				report(a.ID, true, "always on the same date as %s from %d to %d", b.ID, from, till)
+			}
+		}
+	}
+
+	return problems
+}
+
+func lintTranslations(d Definition, langs []language.Tag, report func(string, bool, string, ...interface{})) {
+	translated := func(t TranslatedString, lang language.Tag) bool {
+		if _, ok := t[lang]; ok {
+			return true
+		}
+		_, ok := catalogs[lang][t[language.German]]
+		return ok
+	}
+
+	descriptions := []TranslatedString{d.Description}
+	for _, p := range d.Periods {
+		descriptions = append(descriptions, p.Description)
+	}
+
+	for _, lang := range langs {
+		if !translated(d.Name, lang) {
+			report(d.ID, false, "missing name in %s", lang)
+		}
+		for _, description := range descriptions {
+			if len(description) > 0 && !translated(description, lang) {
+				report(d.ID, true, "missing description in %s", lang)
+				break
+			}
+		}
+	}
+}
+
+func lintRegions(d Definition, report func(string, bool, string, ...interface{})) {
+	nationwide := false
+	applies := map[Region]bool{}
+	for _, p := range d.Periods {
+		nationwide = nationwide || p.Nationwide
+		for _, r := range p.Regions {
+			applies[r] = true
+		}
+	}
+
+	descriptions := []TranslatedString{d.Description}
+	for _, p := range d.Periods {
+		descriptions = append(descriptions, p.Description)
+	}
+
+	for _, description := range descriptions {
+		for lang, text := range description {
+			for _, p := range placeholder.FindAllString(text, -1) {
+				if p != "{regions}" {
+					report(d.ID, false, "unknown placeholder %s in %s description", p, lang)
+				}
+			}
+			for _, r := range AllRegions {
+				name := r.Name().Translate(lang)
+				if !nationwide && !applies[r] && containsWord(text, name) {
+					report(d.ID, false, "%s description mentions %s which the holiday never applies to", lang, name)
+				}
+			}
+		}
+	}
+}
+
+// containsWord reports whether the text contains the name delimited by
+// anything but letters and hyphens, so that "Sachsen" isn't found in
+// "Niedersachsen" or "Sachsen-Anhalt".
+func containsWord(text, name string) bool {
+	isPart := func(r rune) bool {
+		return r == '-' || unicode.IsLetter(r)
+	}
+
+	for i := 0; i < len(text); {
+		j := strings.Index(text[i:], name)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(name)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isPart(before)) && (end == len(text) || !isPart(after)) {
+			return true
+		}
+		i = end
+	}
+	return false
+}
+
+func collide(a, b []time.Time) bool {
+	if len(a) == 0 || len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
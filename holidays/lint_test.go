@@ -0,0 +1,94 @@
+package holidays
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestLintBuiltin(t *testing.T) {
+	for _, p := range Lint(Builtin().Definitions(), Languages(), 1950, 2100) {
+		if !p.Warning {
+			t.Error(p)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	langs := []language.Tag{language.German, language.English}
+	name := TranslatedString{language.German: "Testtag", language.English: "Test day"}
+	nationwide := []Period{{Kind: PublicHoliday, Nationwide: true}}
+
+	tests := []struct {
+		name       string
+		definition Definition
+		want       Problem
+	}{
+		{
+			name:       "duplicate",
+			definition: Definition{ID: "easter", Name: name, Rule: EasterOffset(0), Periods: nationwide},
+			want:       Problem{ID: "easter", Message: "duplicate ID"},
+		},
+		{
+			name:       "missing name",
+			definition: Definition{ID: "test", Name: TranslatedString{language.German: "Testtag"}, Rule: Fixed(6, 1), Periods: nationwide},
+			want:       Problem{ID: "test", Message: "missing name in en"},
+		},
+		{
+			name: "missing description",
+			definition: Definition{ID: "test", Name: name, Rule: Fixed(6, 1), Periods: nationwide,
+				Description: TranslatedString{language.German: "Nur ein Test"}},
+			want: Problem{ID: "test", Warning: true, Message: "missing description in en"},
+		},
+		{
+			name:       "unknown region",
+			definition: Definition{ID: "test", Name: name, Rule: Fixed(6, 1), Periods: []Period{{Kind: RegionalPublicHoliday, Regions: []Region{"DE-XX"}}}},
+			want:       Problem{ID: "test", Message: "unknown region DE-XX"},
+		},
+		{
+			name: "unknown placeholder",
+			definition: Definition{ID: "test", Name: name, Rule: Fixed(6, 1), Periods: nationwide,
+				Description: TranslatedString{language.German: "Feiertag in {region}", language.English: "Holiday in {region}"}},
+			want: Problem{ID: "test", Message: "unknown placeholder {region} in de description"},
+		},
+		{
+			name: "mentioned region",
+			definition: Definition{ID: "test", Name: name, Rule: Fixed(6, 1), Periods: []Period{{Kind: RegionalPublicHoliday, Regions: []Region{Sachsen}}},
+				Description: TranslatedString{language.German: "Feiertag in Sachsen-Anhalt", language.English: "Holiday in Saxony-Anhalt"}},
+			want: Problem{ID: "test", Message: "de description mentions Sachsen-Anhalt which the holiday never applies to"},
+		},
+		{
+			name:       "leap day",
+			definition: Definition{ID: "test", Name: name, Rule: Fixed(2, 29), Periods: nationwide},
+			want:       Problem{ID: "test", Message: "February 29 doesn't exist in 2025"},
+		},
+		{
+			name:       "fifth weekday",
+			definition: Definition{ID: "test", Name: name, Rule: NthWeekday(5, 1, 2), Periods: nationwide},
+			want:       Problem{ID: "test", Message: "5th Monday of February doesn't exist in 2024"},
+		},
+		{
+			name:       "collision",
+			definition: Definition{ID: "test", Name: name, Rule: Relative("easter", 1), Periods: nationwide},
+			want:       Problem{ID: "easter-monday", Warning: true, Message: "always on the same date as test from 2024 to 2026"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			problems := Lint(append(Builtin().Definitions(), test.definition), langs, 2024, 2026)
+
+			found := false
+			for _, p := range problems {
+				if p == test.want {
+					found = true
+				} else if !p.Warning && test.want.Warning {
+					t.Errorf("unexpected problem %s", p)
+				}
+			}
+			if !found {
+				t.Errorf("missing problem %s; got %v", test.want, problems)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package holidays
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+// LoadDefinitions reads a list of holiday definitions in YAML or JSON format
+// and validates each of them. Names and descriptions are keyed by BCP 47
+// language tags:
+//
+//	# definitions.yaml
+//	- id: founders-day
+//	  name:
+//	    de: Gründungstag
+//	    en: Founder's Day
+//	  rule: {type: fixed, month: 6, day: 12}
+//	  periods:
+//	    - kind: observance
+//	      nationwide: true
+func LoadDefinitions(r io.Reader) ([]Definition, error) {
+	definitions, err := DecodeDefinitions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range definitions {
+		if err := d.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return definitions, nil
+}
+
+// DecodeDefinitions is like LoadDefinitions but doesn't validate the
+// definitions, e.g. to report all problems with Lint.
+func DecodeDefinitions(r io.Reader) ([]Definition, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML is a superset of JSON, so both are converted the same way
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid definitions: %w", err)
+	}
+
+	var definitions []Definition
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("invalid definitions: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// Register adds the definitions to the built-in set, so that they are
+// returned by HolidaysForYear and the other package level functions. It is
+// not safe to call Register concurrently with any of those.
+func Register(definitions ...Definition) error {
+	s, err := builtin.With(definitions...)
+	if err != nil {
+		return err
+	}
+	builtin = s
+	return nil
+}
@@ -0,0 +1,131 @@
+package holidays
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+const testDefinitions = `
+- id: founders-day
+  name:
+    de: Gründungstag
+    en: Founder's Day
+  rule: {type: fixed, month: 6, day: 12}
+  periods:
+    - kind: public
+      nationwide: true
+- id: works-council-meeting
+  name:
+    de: Betriebsversammlung
+  rule: {type: nth-weekday, nth: 1, weekday: monday, month: 3}
+  periods:
+    - kind: observance
+      regions: [DE-BY]
+- id: office-closure
+  name:
+    de: Betriebsruhe
+  rule: {type: relative, base: second-christmas-day, offset: 1}
+  periods:
+    - kind: regional
+      regions: [DE-BY]
+      from: 2025
+`
+
+func TestLoadDefinitions(t *testing.T) {
+	definitions, err := LoadDefinitions(strings.NewReader(testDefinitions))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Builtin().With(definitions...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]time.Time{
+		"founders-day":          time.Date(2025, 6, 12, 0, 0, 0, 0, time.UTC),
+		"works-council-meeting": time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+		"office-closure":        time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC),
+	}
+	for _, h := range s.HolidaysAndObservancesForRegion(2025, Bayern) {
+		if date, ok := want[h.ID]; ok {
+			if h.Date != date {
+				t.Errorf("%s: got %s; want %s", h.ID, h.Date.Format("2006-01-02"), date.Format("2006-01-02"))
+			}
+			delete(want, h.ID)
+		}
+	}
+	for id := range want {
+		t.Errorf("%s is missing", id)
+	}
+
+	founder, _ := s.Lookup("founders-day")
+	if founder.Name[language.English] != "Founder's Day" {
+		t.Errorf("got name %q", founder.Name[language.English])
+	}
+}
+
+func TestLoadDefinitionsJSON(t *testing.T) {
+	const data = `[{"id": "x", "name": {"de": "X"}, "rule": {"type": "easter", "offset": 3}, "periods": [{"kind": "observance", "nationwide": true}]}]`
+
+	definitions, err := LoadDefinitions(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 1 || definitions[0].Rule != EasterOffset(3) {
+		t.Errorf("got %+v", definitions)
+	}
+}
+
+func TestLoadDefinitionsErrors(t *testing.T) {
+	invalid := []string{
+		`- id: x`,
+		`- {id: x, name: {de: X}, rule: {type: lunar}, periods: [{kind: public}]}`,
+		`- {id: x, name: {de: X}, rule: {type: fixed, month: 1, day: 1}, periods: [{kind: bank-holiday}]}`,
+		`- {id: x, name: {de: X}, rule: {type: nth-weekday, nth: 1, weekday: funday, month: 1}, periods: [{kind: public}]}`,
+		`{id: x}`,
+		`- {id: x, name: {de: X}, rule: {type: fixed, month: 1, day: 1}, periods: [{kind: regional, regions: [DE-BYY]}]}`,
+		`- {id: x, name: {de: X}, rule: {type: fixed, month: 1, day: 1}, periods: [{kind: regional, regions: [BY]}]}`,
+	}
+	for _, data := range invalid {
+		if _, err := LoadDefinitions(strings.NewReader(data)); err == nil {
+			t.Errorf("expected error for %s", data)
+		}
+	}
+}
+
+func TestLoadDefinitionsRegions(t *testing.T) {
+	const data = `- {id: x, name: {de: X}, rule: {type: fixed, month: 1, day: 1}, periods: [{kind: regional, regions: [de-by, " DE-sn "]}]}`
+
+	definitions, err := LoadDefinitions(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Region{Bayern, Sachsen}
+	if got := definitions[0].Periods[0].Regions; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestDecodeDefinitions(t *testing.T) {
+	const data = `
+- {id: first, name: {de: Erster}, rule: {type: fixed, month: 2, day: 30}, periods: [{kind: public, nationwide: true}]}
+- {id: second, name: {de: Zweiter}, rule: {type: fixed, month: 1, day: 1}, periods: [{kind: regional, regions: [DE-XX]}]}
+`
+
+	definitions, err := DecodeDefinitions(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 2 {
+		t.Fatalf("got %d definitions; want 2", len(definitions))
+	}
+	if _, err := LoadDefinitions(strings.NewReader(data)); err == nil {
+		t.Error("expected error for invalid definitions")
+	}
+}
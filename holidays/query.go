@@ -0,0 +1,119 @@
+package holidays
+
+import "time"
+
+// searchYears limits how far NextHoliday and PreviousHoliday look ahead or
+// back for a matching holiday.
+const searchYears = 100
+
+// Filter selects holidays in queries. A holiday is selected if all filters
+// return true.
+type Filter func(Holiday) bool
+
+// InRegion selects the holidays that apply in the region.
+func InRegion(region Region) Filter {
+	return func(h Holiday) bool {
+		return h.AppliesTo(region)
+	}
+}
+
+// OfKind selects the holidays of any of the given kinds.
+func OfKind(kinds ...Kind) Filter {
+	return func(h Holiday) bool {
+		for _, k := range kinds {
+			if h.Kind == k {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func matches(h Holiday, filters []Filter) bool {
+	for _, f := range filters {
+		if !f(h) {
+			return false
+		}
+	}
+	return true
+}
+
+// HolidaysOn returns the holidays on the date of t.
+func (s *Set) HolidaysOn(t time.Time, filters ...Filter) []Holiday {
+	return s.HolidaysBetween(t, t, filters...)
+}
+
+// HolidaysBetween returns the holidays from the date of from up to and
+// including the date of to, sorted by date.
+func (s *Set) HolidaysBetween(from, to time.Time, filters ...Filter) []Holiday {
+	from, to = day(from), day(to)
+	holidays := []Holiday{}
+
+	for year := from.Year(); year <= to.Year(); year++ {
+		for _, h := range s.HolidaysForYear(year) {
+			if h.Date.Before(from) || h.Date.After(to) || !matches(h, filters) {
+				continue
+			}
+			holidays = append(holidays, h)
+		}
+	}
+
+	return holidays
+}
+
+// NextHoliday returns the first holiday after the date of t.
+func (s *Set) NextHoliday(t time.Time, filters ...Filter) (Holiday, bool) {
+	t = day(t)
+
+	for year := t.Year(); year <= t.Year()+searchYears; year++ {
+		for _, h := range s.HolidaysForYear(year) {
+			if h.Date.After(t) && matches(h, filters) {
+				return h, true
+			}
+		}
+	}
+
+	return Holiday{}, false
+}
+
+// PreviousHoliday returns the last holiday before the date of t.
+func (s *Set) PreviousHoliday(t time.Time, filters ...Filter) (Holiday, bool) {
+	t = day(t)
+
+	for year := t.Year(); year >= t.Year()-searchYears; year-- {
+		holidays := s.HolidaysForYear(year)
+		for i := len(holidays) - 1; i >= 0; i-- {
+			if h := holidays[i]; h.Date.Before(t) && matches(h, filters) {
+				return h, true
+			}
+		}
+	}
+
+	return Holiday{}, false
+}
+
+// IsHoliday reports whether any holiday selected by the filters falls on the
+// date of t.
+func (s *Set) IsHoliday(t time.Time, filters ...Filter) bool {
+	return len(s.HolidaysOn(t, filters...)) > 0
+}
+
+func HolidaysOn(t time.Time, filters ...Filter) []Holiday {
+	return builtin.HolidaysOn(t, filters...)
+}
+
+func HolidaysBetween(from, to time.Time, filters ...Filter) []Holiday {
+	return builtin.HolidaysBetween(from, to, filters...)
+}
+
+func NextHoliday(t time.Time, filters ...Filter) (Holiday, bool) {
+	return builtin.NextHoliday(t, filters...)
+}
+
+func PreviousHoliday(t time.Time, filters ...Filter) (Holiday, bool) {
+	return builtin.PreviousHoliday(t, filters...)
+}
+
+func IsHoliday(t time.Time, filters ...Filter) bool {
+	return builtin.IsHoliday(t, filters...)
+}
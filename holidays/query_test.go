@@ -0,0 +1,57 @@
+package holidays
+
+import "testing"
+
+func ids(holidays []Holiday) []string {
+	ids := []string{}
+	for _, h := range holidays {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func TestHolidaysOn(t *testing.T) {
+	got := ids(HolidaysOn(date(2025, 6, 19), InRegion(Bayern)))
+	if len(got) != 1 || got[0] != "corpus-christi" {
+		t.Errorf("got %v; want [corpus-christi]", got)
+	}
+
+	if IsHoliday(date(2025, 6, 19), InRegion(Hamburg)) {
+		t.Error("Fronleichnam is no holiday in Hamburg")
+	}
+	if !IsHoliday(date(2025, 8, 15), InRegion(Saarland), OfKind(PublicHoliday, RegionalPublicHoliday)) {
+		t.Error("Mariä Himmelfahrt is a holiday in Saarland")
+	}
+}
+
+func TestHolidaysBetween(t *testing.T) {
+	got := ids(HolidaysBetween(date(2025, 12, 24), date(2026, 1, 6), InRegion(Hamburg), OfKind(PublicHoliday, RegionalPublicHoliday)))
+	want := []string{"first-christmas-day", "second-christmas-day", "new-year"}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v; want %v", got, want)
+		}
+	}
+}
+
+func TestNextHoliday(t *testing.T) {
+	public := OfKind(PublicHoliday, RegionalPublicHoliday)
+
+	next, ok := NextHoliday(date(2025, 12, 26), InRegion(Bayern), public)
+	if !ok || next.Date != date(2026, 1, 1) {
+		t.Errorf("got %s %s; want new-year 2026-01-01", next.ID, next.Date.Format("2006-01-02"))
+	}
+
+	previous, ok := PreviousHoliday(date(2026, 1, 1), InRegion(Bayern), public)
+	if !ok || previous.Date != date(2025, 12, 26) {
+		t.Errorf("got %s %s; want second-christmas-day 2025-12-26", previous.ID, previous.Date.Format("2006-01-02"))
+	}
+
+	if _, ok := NextHoliday(date(2025, 6, 1), func(h Holiday) bool { return false }); ok {
+		t.Error("expected no holiday")
+	}
+}
@@ -0,0 +1,120 @@
+package holidays
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Region is an ISO 3166-2 subdivision code of a German state, e.g. "DE-BY".
+type Region string
+
+const (
+	BadenWürttemberg      Region = "DE-BW"
+	Bayern                Region = "DE-BY"
+	Berlin                Region = "DE-BE"
+	Brandenburg           Region = "DE-BB"
+	Bremen                Region = "DE-HB"
+	Hamburg               Region = "DE-HH"
+	Hessen                Region = "DE-HE"
+	MecklenburgVorpommern Region = "DE-MV"
+	Niedersachsen         Region = "DE-NI"
+	NordrheinWestfalen    Region = "DE-NW"
+	RheinlandPfalz        Region = "DE-RP"
+	Saarland              Region = "DE-SL"
+	Sachsen               Region = "DE-SN"
+	SachsenAnhalt         Region = "DE-ST"
+	SchleswigHolstein     Region = "DE-SH"
+	Thüringen             Region = "DE-TH"
+)
+
+// AllRegions lists the subdivisions of Germany in alphabetical order of their
+// German names.
+var AllRegions = []Region{
+	BadenWürttemberg,
+	Bayern,
+	Berlin,
+	Brandenburg,
+	Bremen,
+	Hamburg,
+	Hessen,
+	MecklenburgVorpommern,
+	Niedersachsen,
+	NordrheinWestfalen,
+	RheinlandPfalz,
+	Saarland,
+	Sachsen,
+	SachsenAnhalt,
+	SchleswigHolstein,
+	Thüringen,
+}
+
+var regionNames = map[Region]TranslatedString{
+	BadenWürttemberg:      {language.German: "Baden-Württemberg", language.English: "Baden-Württemberg"},
+	Bayern:                {language.German: "Bayern", language.English: "Bavaria"},
+	Berlin:                {language.German: "Berlin", language.English: "Berlin"},
+	Brandenburg:           {language.German: "Brandenburg", language.English: "Brandenburg"},
+	Bremen:                {language.German: "Bremen", language.English: "Bremen"},
+	Hamburg:               {language.German: "Hamburg", language.English: "Hamburg"},
+	Hessen:                {language.German: "Hessen", language.English: "Hesse"},
+	MecklenburgVorpommern: {language.German: "Mecklenburg-Vorpommern", language.English: "Mecklenburg-Western Pomerania"},
+	Niedersachsen:         {language.German: "Niedersachsen", language.English: "Lower Saxony"},
+	NordrheinWestfalen:    {language.German: "Nordrhein-Westfalen", language.English: "North Rhine-Westphalia"},
+	RheinlandPfalz:        {language.German: "Rheinland-Pfalz", language.English: "Rhineland-Palatinate"},
+	Saarland:              {language.German: "Saarland", language.English: "Saarland"},
+	Sachsen:               {language.German: "Sachsen", language.English: "Saxony"},
+	SachsenAnhalt:         {language.German: "Sachsen-Anhalt", language.English: "Saxony-Anhalt"},
+	SchleswigHolstein:     {language.German: "Schleswig-Holstein", language.English: "Schleswig-Holstein"},
+	Thüringen:             {language.German: "Thüringen", language.English: "Thuringia"},
+}
+
+// Name returns the translated name of the region.
+func (r Region) Name() TranslatedString {
+	return regionNames[r]
+}
+
+// AppliesTo reports whether the holiday applies in the given region.
+func (h Holiday) AppliesTo(region Region) bool {
+	if h.Nationwide {
+		return true
+	}
+	for _, r := range h.Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRegion returns the region with the given ISO 3166-2 code. The code is
+// matched case-insensitively.
+func ParseRegion(s string) (Region, error) {
+	code := normalizeRegion(s)
+	if !code.IsValid() {
+		return "", fmt.Errorf("unknown region '%s'", s)
+	}
+	return code, nil
+}
+
+// IsValid reports whether the region is one of AllRegions.
+func (r Region) IsValid() bool {
+	for _, region := range AllRegions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalText normalizes the code like ParseRegion, e.g. "de-by" to
+// "DE-BY". Unknown codes are kept so that Definition.Validate can report
+// them.
+func (r *Region) UnmarshalText(text []byte) error {
+	*r = normalizeRegion(string(text))
+	return nil
+}
+
+func normalizeRegion(s string) Region {
+	return Region(strings.ToUpper(strings.TrimSpace(s)))
+}
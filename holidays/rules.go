@@ -0,0 +1,281 @@
+package holidays
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RuleType names a way of computing the date of a holiday.
+type RuleType string
+
+const (
+	// RuleFixed is the same day every year, e.g. December 25.
+	RuleFixed RuleType = "fixed"
+	// RuleEaster is a number of days before or after Easter Sunday.
+	RuleEaster RuleType = "easter"
+	// RuleNthWeekday is the n-th weekday of a month. A negative n counts
+	// from the end of the month, -1 being the last weekday of the month.
+	RuleNthWeekday RuleType = "nth-weekday"
+	// RuleWeekdayBefore is the last weekday strictly before a day.
+	RuleWeekdayBefore RuleType = "weekday-before"
+	// RuleWeekdayAfter is the first weekday strictly after a day.
+	RuleWeekdayAfter RuleType = "weekday-after"
+	// RuleRelative is a number of days before or after another holiday.
+	RuleRelative RuleType = "relative"
+)
+
+// Rule computes the date of a holiday for a given year. Which of the fields
+// are used depends on the type of the rule.
+type Rule struct {
+	Type    RuleType
+	Month   time.Month
+	Day     int
+	Weekday time.Weekday
+	// N selects the occurrence of the weekday for RuleNthWeekday.
+	N int
+	// Offset is the number of days added for RuleEaster and RuleRelative.
+	Offset int
+	// Base is the ID of the holiday RuleRelative refers to.
+	Base string
+}
+
+func Fixed(month time.Month, day int) Rule {
+	return Rule{Type: RuleFixed, Month: month, Day: day}
+}
+
+func EasterOffset(days int) Rule {
+	return Rule{Type: RuleEaster, Offset: days}
+}
+
+func NthWeekday(n int, weekday time.Weekday, month time.Month) Rule {
+	return Rule{Type: RuleNthWeekday, N: n, Weekday: weekday, Month: month}
+}
+
+func LastWeekday(weekday time.Weekday, month time.Month) Rule {
+	return NthWeekday(-1, weekday, month)
+}
+
+func WeekdayBefore(weekday time.Weekday, month time.Month, day int) Rule {
+	return Rule{Type: RuleWeekdayBefore, Weekday: weekday, Month: month, Day: day}
+}
+
+func WeekdayAfter(weekday time.Weekday, month time.Month, day int) Rule {
+	return Rule{Type: RuleWeekdayAfter, Weekday: weekday, Month: month, Day: day}
+}
+
+func Relative(base string, days int) Rule {
+	return Rule{Type: RuleRelative, Base: base, Offset: days}
+}
+
+// Validate checks that the fields required by the type of the rule are set
+// and within range.
+func (r Rule) Validate() error {
+	validMonth := func() error {
+		if r.Month < time.January || r.Month > time.December {
+			return fmt.Errorf("%s rule: invalid month %d", r.Type, r.Month)
+		}
+		return nil
+	}
+	validDay := func() error {
+		if err := validMonth(); err != nil {
+			return err
+		}
+		// Use a leap year so that February 29 is accepted
+		if r.Day < 1 || r.Day > daysIn(r.Month, 2000) {
+			return fmt.Errorf("%s rule: invalid day %d of %s", r.Type, r.Day, r.Month)
+		}
+		return nil
+	}
+	validWeekday := func() error {
+		if r.Weekday < time.Sunday || r.Weekday > time.Saturday {
+			return fmt.Errorf("%s rule: invalid weekday %d", r.Type, r.Weekday)
+		}
+		return nil
+	}
+
+	switch r.Type {
+	case RuleFixed:
+		return validDay()
+	case RuleEaster:
+		return nil
+	case RuleNthWeekday:
+		if r.N == 0 || r.N < -5 || r.N > 5 {
+			return fmt.Errorf("%s rule: invalid occurrence %d", r.Type, r.N)
+		}
+		if err := validWeekday(); err != nil {
+			return err
+		}
+		return validMonth()
+	case RuleWeekdayBefore, RuleWeekdayAfter:
+		if err := validWeekday(); err != nil {
+			return err
+		}
+		return validDay()
+	case RuleRelative:
+		if r.Base == "" {
+			return fmt.Errorf("%s rule: missing base holiday", r.Type)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown rule type '%s'", r.Type)
+	}
+}
+
+// date computes the date of the rule in the given year. The date of the
+// holiday a relative rule refers to is looked up with base.
+func (r Rule) date(year int, base func(id string, year int) (time.Time, error)) (time.Time, error) {
+	switch r.Type {
+	case RuleFixed:
+		if r.Day > daysIn(r.Month, year) {
+			return time.Time{}, fmt.Errorf("%s doesn't exist in %d", r, year)
+		}
+		return time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC), nil
+	case RuleEaster:
+		return easterDate(year).AddDate(0, 0, r.Offset), nil
+	case RuleNthWeekday:
+		var date time.Time
+		if r.N > 0 {
+			date = time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+			for date.Weekday() != r.Weekday {
+				date = date.AddDate(0, 0, 1)
+			}
+			date = date.AddDate(0, 0, 7*(r.N-1))
+		} else {
+			date = time.Date(year, r.Month+1, 0, 0, 0, 0, 0, time.UTC)
+			for date.Weekday() != r.Weekday {
+				date = date.AddDate(0, 0, -1)
+			}
+			date = date.AddDate(0, 0, 7*(r.N+1))
+		}
+		if date.Month() != r.Month {
+			return time.Time{}, fmt.Errorf("%s doesn't exist in %d", r, year)
+		}
+		return date, nil
+	case RuleWeekdayBefore:
+		date := time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+		for date.Weekday() != r.Weekday {
+			date = date.AddDate(0, 0, -1)
+		}
+		return date, nil
+	case RuleWeekdayAfter:
+		date := time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+		for date.Weekday() != r.Weekday {
+			date = date.AddDate(0, 0, 1)
+		}
+		return date, nil
+	case RuleRelative:
+		date, err := base(r.Base, year)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return date.AddDate(0, 0, r.Offset), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown rule type '%s'", r.Type)
+	}
+}
+
+func (r Rule) String() string {
+	offset := func(days int) string {
+		if days < 0 {
+			return fmt.Sprintf(" - %d days", -days)
+		}
+		return fmt.Sprintf(" + %d days", days)
+	}
+
+	switch r.Type {
+	case RuleFixed:
+		return fmt.Sprintf("%s %d", r.Month, r.Day)
+	case RuleEaster:
+		if r.Offset == 0 {
+			return "Easter Sunday"
+		}
+		return "Easter Sunday" + offset(r.Offset)
+	case RuleNthWeekday:
+		if r.N == -1 {
+			return fmt.Sprintf("last %s of %s", r.Weekday, r.Month)
+		}
+		if r.N < 0 {
+			return fmt.Sprintf("%s last %s of %s", ordinal(-r.N), r.Weekday, r.Month)
+		}
+		return fmt.Sprintf("%s %s of %s", ordinal(r.N), r.Weekday, r.Month)
+	case RuleWeekdayBefore:
+		return fmt.Sprintf("%s before %s %d", r.Weekday, r.Month, r.Day)
+	case RuleWeekdayAfter:
+		return fmt.Sprintf("%s after %s %d", r.Weekday, r.Month, r.Day)
+	case RuleRelative:
+		if r.Offset == 0 {
+			return r.Base
+		}
+		return r.Base + offset(r.Offset)
+	default:
+		return fmt.Sprintf("unknown rule type '%s'", r.Type)
+	}
+}
+
+// ruleJSON is the serialized form of a rule. Only the fields used by the
+// type of the rule are set and weekdays are written as names.
+type ruleJSON struct {
+	Type    RuleType   `json:"type"`
+	Month   time.Month `json:"month,omitempty"`
+	Day     int        `json:"day,omitempty"`
+	Weekday string     `json:"weekday,omitempty"`
+	N       int        `json:"nth,omitempty"`
+	Offset  int        `json:"offset,omitempty"`
+	Base    string     `json:"base,omitempty"`
+}
+
+func (r Rule) MarshalJSON() ([]byte, error) {
+	v := ruleJSON{Type: r.Type, Month: r.Month, Day: r.Day, N: r.N, Offset: r.Offset, Base: r.Base}
+	if r.Type == RuleNthWeekday || r.Type == RuleWeekdayBefore || r.Type == RuleWeekdayAfter {
+		v.Weekday = strings.ToLower(r.Weekday.String())
+	}
+	return json.Marshal(v)
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) error {
+	var v ruleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*r = Rule{Type: v.Type, Month: v.Month, Day: v.Day, N: v.N, Offset: v.Offset, Base: v.Base}
+	if v.Weekday != "" {
+		weekday, err := ParseWeekday(v.Weekday)
+		if err != nil {
+			return err
+		}
+		r.Weekday = weekday
+	}
+
+	return nil
+}
+
+// ParseWeekday returns the weekday with the given English name, e.g.
+// "monday". The name is matched case-insensitively.
+func ParseWeekday(s string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(s, weekday.String()) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday '%s'", s)
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
@@ -0,0 +1,119 @@
+package holidays
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestRules(t *testing.T) {
+	testCases := []struct {
+		rule Rule
+		year int
+		want time.Time
+	}{
+		{Fixed(time.March, 8), 2021, time.Date(2021, 3, 8, 0, 0, 0, 0, time.UTC)},
+		{EasterOffset(1), 2021, time.Date(2021, 4, 5, 0, 0, 0, 0, time.UTC)},
+		{NthWeekday(1, time.Monday, time.March), 2021, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{NthWeekday(2, time.Sunday, time.May), 2021, time.Date(2021, 5, 9, 0, 0, 0, 0, time.UTC)},
+		{NthWeekday(-2, time.Sunday, time.May), 2021, time.Date(2021, 5, 23, 0, 0, 0, 0, time.UTC)},
+		{LastWeekday(time.Sunday, time.October), 2021, time.Date(2021, 10, 31, 0, 0, 0, 0, time.UTC)},
+		{WeekdayBefore(time.Wednesday, time.November, 23), 2022, time.Date(2022, 11, 16, 0, 0, 0, 0, time.UTC)},
+		{WeekdayBefore(time.Sunday, time.December, 25), 2022, time.Date(2022, 12, 18, 0, 0, 0, 0, time.UTC)},
+		{WeekdayAfter(time.Thursday, time.November, 1), 2021, time.Date(2021, 11, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.rule.String(), func(t *testing.T) {
+			got, err := tc.rule.date(tc.year, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %s; want %s", got.Format("2006-01-02"), tc.want.Format("2006-01-02"))
+			}
+		})
+	}
+}
+
+func TestRuleErrors(t *testing.T) {
+	invalid := []Rule{
+		{Type: "lunar"},
+		Fixed(time.February, 30),
+		Fixed(13, 1),
+		NthWeekday(0, time.Monday, time.May),
+		WeekdayBefore(7, time.May, 1),
+		Relative("", 1),
+	}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", r)
+		}
+	}
+
+	if _, err := Fixed(time.February, 29).date(2021, nil); err == nil {
+		t.Error("expected error for February 29 in 2021")
+	}
+	if _, err := NthWeekday(5, time.Monday, time.February).date(2021, nil); err == nil {
+		t.Error("expected error for 5th Monday of February 2021")
+	}
+}
+
+func TestRuleJSON(t *testing.T) {
+	for _, d := range Builtin().Definitions() {
+		t.Run(d.ID, func(t *testing.T) {
+			data, err := json.Marshal(d.Rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Rule
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got != d.Rule {
+				t.Errorf("got %s; want %s (%s)", got, d.Rule, data)
+			}
+		})
+	}
+}
+
+func TestNewSet(t *testing.T) {
+	def := func(id string, rule Rule) Definition {
+		return Definition{
+			ID:      id,
+			Name:    TranslatedString{language.German: id},
+			Rule:    rule,
+			Periods: always(Observance),
+		}
+	}
+
+	invalid := map[string][]Definition{
+		"duplicate": {def("a", Fixed(time.May, 1)), def("a", Fixed(time.May, 2))},
+		"unknown":   {def("a", Relative("b", 1))},
+		"cycle":     {def("a", Relative("b", 1)), def("b", Relative("a", 1))},
+	}
+	for name, defs := range invalid {
+		if _, err := NewSet(defs...); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	for _, name := range []string{"monday", "Monday", "MONDAY"} {
+		if got, err := ParseWeekday(name); err != nil || got != time.Monday {
+			t.Errorf("%s: got %s, %v; want Monday", name, got, err)
+		}
+	}
+	if got, err := ParseWeekday("sunday"); err != nil || got != time.Sunday {
+		t.Errorf("got %s, %v; want Sunday", got, err)
+	}
+
+	for _, name := range []string{"", "mon", "Montag"} {
+		if _, err := ParseWeekday(name); err == nil {
+			t.Errorf("%q: expected error", name)
+		}
+	}
+}
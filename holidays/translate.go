@@ -0,0 +1,54 @@
+package holidays
+
+import (
+	"sort"
+
+	"golang.org/x/text/language"
+)
+
+// Translate returns the translation best matching lang, e.g. English for
+// en-GB. Translations of the German text in the catalogs are considered as
+// well. If there is no match, it falls back to German, the language every
+// built-in holiday is defined in, or any other available translation.
+func (t TranslatedString) Translate(lang language.Tag) string {
+	text, _ := t.Lookup(lang)
+	return text
+}
+
+// Lookup is like Translate but also reports whether a translation is
+// available at all.
+func (t TranslatedString) Lookup(lang language.Tag) (string, bool) {
+	if len(t) == 0 {
+		return "", false
+	}
+	if text, ok := t[lang]; ok {
+		return text, true
+	}
+	if text, ok := catalogs[lang][t[language.German]]; ok {
+		return text, true
+	}
+
+	t = t.withCatalogs()
+	tags := t.Languages()
+	_, i, _ := language.NewMatcher(tags).Match(lang)
+
+	return t[tags[i]], true
+}
+
+// Languages returns the languages of the translations. German comes first
+// as the fallback, the others are sorted.
+func (t TranslatedString) Languages() []language.Tag {
+	tags := make([]language.Tag, 0, len(t))
+	for tag := range t {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i] == language.German || tags[j] == language.German {
+			return tags[i] == language.German
+		}
+		return tags[i].String() < tags[j].String()
+	})
+
+	return tags
+}
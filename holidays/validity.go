@@ -0,0 +1,93 @@
+package holidays
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Period describes how a holiday is observed during a range of years.
+//
+// A period with From == Till describes a one-off holiday, e.g. the 500th
+// anniversary of the Reformation in 2017.
+type Period struct {
+	// From and Till are the first and the last year of the period. Zero
+	// leaves the period open at that end.
+	From       int      `json:"from,omitempty"`
+	Till       int      `json:"till,omitempty"`
+	Kind       Kind     `json:"kind"`
+	Nationwide bool     `json:"nationwide,omitempty"`
+	Regions    []Region `json:"regions,omitempty"`
+	// Description replaces the description of the holiday if set.
+	Description TranslatedString `json:"description,omitempty"`
+}
+
+// Contains reports whether the year lies within the period.
+func (p Period) Contains(year int) bool {
+	return (p.From == 0 || p.From <= year) && (p.Till == 0 || year <= p.Till)
+}
+
+// IsObserved reports whether the holiday applies anywhere.
+func (h Holiday) IsObserved() bool {
+	return h.Nationwide || len(h.Regions) > 0
+}
+
+// observe returns the holiday once for every period that contains the year.
+// Each period applies to its regions that aren't covered by an earlier one
+// already, so a nationwide period following regional ones applies to all
+// other regions. If no period contains the year, the holiday isn't observed.
+//
+// The placeholder "{regions}" in the description is replaced by the names of
+// the regions the holiday applies to.
+func observe(h Holiday, year int, periods ...Period) []Holiday {
+	var observed []Holiday
+	covered := map[Region]bool{}
+
+	for _, p := range periods {
+		if !p.Contains(year) {
+			continue
+		}
+
+		o := h
+		o.Kind, o.Nationwide, o.Regions = p.Kind, false, nil
+		if p.Description != nil {
+			o.Description = p.Description
+		}
+		regions := p.Regions
+		if p.Nationwide {
+			o.Nationwide = len(covered) == 0
+			regions = AllRegions
+		}
+		for _, r := range regions {
+			if !covered[r] {
+				covered[r] = true
+				if !o.Nationwide {
+					o.Regions = append(o.Regions, r)
+				}
+			}
+		}
+		if !o.IsObserved() {
+			continue
+		}
+
+		description := TranslatedString{}
+		for lang, text := range o.Description.withCatalogs() {
+			description[lang] = strings.ReplaceAll(text, "{regions}", regionList(o.Regions, lang))
+		}
+		o.Description = description
+
+		observed = append(observed, o)
+	}
+
+	return observed
+}
+
+func regionList(regions []Region, lang language.Tag) string {
+	names := make([]string, len(regions))
+
+	for i, r := range regions {
+		names[i] = r.Name().Translate(lang)
+	}
+
+	return strings.Join(names, ", ")
+}